@@ -0,0 +1,15 @@
+# Reference a shared on-call contact by name instead of its numeric ID
+data "uptimerobot_alert_contact" "on_call" {
+  friendly_name = "SRE On-call"
+  type          = "email"
+}
+
+resource "uptimerobot_monitor" "web" {
+  friendly_name = "My Monitor"
+  type          = "http"
+  url           = "http://example.com"
+
+  alert_contact {
+    id = data.uptimerobot_alert_contact.on_call.id
+  }
+}
@@ -0,0 +1,5 @@
+# Every active Slack contact of the account
+data "uptimerobot_alert_contacts" "slack" {
+  types    = ["slack"]
+  statuses = ["active"]
+}
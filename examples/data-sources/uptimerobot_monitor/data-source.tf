@@ -0,0 +1,9 @@
+# Look up a monitor created by another team
+data "uptimerobot_monitor" "shop" {
+  friendly_name = "Shop"
+}
+
+resource "uptimerobot_status_page" "shop" {
+  friendly_name = "Shop Status"
+  monitor_ids   = [data.uptimerobot_monitor.shop.id]
+}
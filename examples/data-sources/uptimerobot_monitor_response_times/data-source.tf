@@ -0,0 +1,14 @@
+# Hourly response times of the shop monitor over the first week of the year
+data "uptimerobot_monitor_response_times" "shop" {
+  monitor_id = "12345"
+  start_date = "2021-01-01T00:00:00Z"
+  end_date   = "2021-01-08T00:00:00Z"
+  average    = 60
+}
+
+check "shop_latency" {
+  assert {
+    condition     = data.uptimerobot_monitor_response_times.shop.p95_response_time < 800
+    error_message = "The shop's 95th percentile response time exceeded 800 ms."
+  }
+}
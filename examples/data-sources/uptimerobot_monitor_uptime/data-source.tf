@@ -0,0 +1,17 @@
+# Uptime of the shop monitors over the last 30 days and the first quarter
+data "uptimerobot_monitor_uptime" "shop" {
+  monitor_ids = ["12345", "12346"]
+  periods     = [30]
+
+  range {
+    start = "2021-01-01T00:00:00Z"
+    end   = "2021-04-01T00:00:00Z"
+  }
+}
+
+check "shop_slo" {
+  assert {
+    condition     = data.uptimerobot_monitor_uptime.shop.custom_uptime_ratios[0] >= 99.9
+    error_message = "The shop missed its 99.9% uptime SLO over the last 30 days."
+  }
+}
@@ -0,0 +1,10 @@
+# Every production keyword monitor that is currently down
+data "uptimerobot_monitors" "prod_down" {
+  types      = ["keyword"]
+  statuses   = ["seems_down", "down"]
+  name_regex = "^prod-"
+}
+
+output "prod_down" {
+  value = data.uptimerobot_monitors.prod_down.monitors[*].friendly_name
+}
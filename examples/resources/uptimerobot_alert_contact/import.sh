@@ -0,0 +1,6 @@
+# Import an alert contact by its ID
+terraform import uptimerobot_alert_contact.oncall 1234567
+
+# ... or by type and value, or by friendly name
+terraform import uptimerobot_alert_contact.oncall email:oncall@example.com
+terraform import uptimerobot_alert_contact.slack "name=Ops Slack"
@@ -1,6 +1,24 @@
 # Create a alert contact
 resource "uptimerobot_alert_contact" "test" {
   friendly_name = "Email"
-  type          = "mail"
-  url           = "test@example.com"
+  type          = "email"
+  value         = "test@example.com"
+}
+
+# Post alerts as JSON to an incident bot
+resource "uptimerobot_alert_contact" "incident_bot" {
+  friendly_name = "Incident Bot"
+  type          = "webhook"
+
+  webhook {
+    url          = "https://bot.example.com/uptimerobot"
+    http_method  = "post"
+    content_mode = "json"
+    payload = jsonencode({
+      monitor = "*monitorFriendlyName*"
+      url     = "*monitorURL*"
+      alert   = "*alertTypeFriendlyName*"
+      details = "*alertDetails*"
+    })
+  }
 }
@@ -0,0 +1,2 @@
+# Import a maintenance window by its ID
+terraform import uptimerobot_maintenance_window.deploy 12345
@@ -0,0 +1,15 @@
+# Silence alerts during the weekly deploy on Tuesday and Thursday evenings
+resource "uptimerobot_maintenance_window" "deploy" {
+  friendly_name = "Weekly Deploy"
+  type          = "weekly"
+  start_time    = "22:30"
+  duration      = 60
+  value         = [2, 4]
+}
+
+resource "uptimerobot_monitor" "web" {
+  friendly_name          = "My Monitor"
+  type                   = "http"
+  url                    = "http://example.com"
+  maintenance_window_ids = [uptimerobot_maintenance_window.deploy.id]
+}
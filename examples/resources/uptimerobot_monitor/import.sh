@@ -0,0 +1,10 @@
+# Import a monitor by its ID
+terraform import uptimerobot_monitor.web 123456789
+
+# ... or by URL, friendly name, or type and URL
+terraform import uptimerobot_monitor.web url=https://example.com
+terraform import uptimerobot_monitor.web "name=My Monitor"
+terraform import uptimerobot_monitor.web http:https://example.com
+
+# An import ID that matches more than one monitor fails and lists the IDs of
+# the candidates.
@@ -3,4 +3,62 @@ resource "uptimerobot_monitor" "web" {
   friendly_name = "My Monitor"
   type          = "http"
   url           = "http://example.com"
-}
\ No newline at end of file
+}
+
+# Alert when a page stops containing a keyword
+resource "uptimerobot_monitor" "keyword" {
+  friendly_name = "My Keyword Monitor"
+  type          = "keyword"
+  url           = "https://example.com"
+  keyword_type  = "not_exists"
+  keyword_value = "Welcome"
+}
+
+# Count a login redirect as up and rate limiting as down
+resource "uptimerobot_monitor" "login" {
+  friendly_name = "Login"
+  type          = "http"
+  url           = "https://example.com/login"
+
+  custom_http_statuses {
+    up   = [302, 401]
+    down = [429]
+  }
+}
+
+# POST a GraphQL health query
+resource "uptimerobot_monitor" "graphql" {
+  friendly_name     = "GraphQL API"
+  type              = "http"
+  url               = "https://api.example.com/graphql"
+  http_method       = "post"
+  post_type         = "raw_json"
+  post_content_type = "application/json"
+  post_value        = jsonencode({ query = "{ health }" })
+
+  custom_http_headers = {
+    Authorization = "Bearer ${var.health_token}"
+  }
+}
+
+# Expect a nightly job to ping heartbeat_url at least once a day
+resource "uptimerobot_monitor" "backup" {
+  friendly_name = "Nightly Backup"
+  type          = "heartbeat"
+  interval      = 86400
+}
+
+# Send no alerts during the nightly maintenance window
+resource "uptimerobot_maintenance_window" "nightly" {
+  friendly_name = "Nightly Maintenance"
+  type          = "daily"
+  start_time    = "03:00"
+  duration      = 30
+}
+
+resource "uptimerobot_monitor" "shop" {
+  friendly_name          = "Shop"
+  type                   = "http"
+  url                    = "https://shop.example.com"
+  maintenance_window_ids = [uptimerobot_maintenance_window.nightly.id]
+}
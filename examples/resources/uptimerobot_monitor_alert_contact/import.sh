@@ -0,0 +1,2 @@
+# Import an attachment by <monitor ID>/<alert contact ID>
+terraform import uptimerobot_monitor_alert_contact.on_call 12345/1234567
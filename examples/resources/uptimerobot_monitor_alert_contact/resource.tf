@@ -0,0 +1,14 @@
+# Attach an alert contact managed in another configuration to a monitor
+resource "uptimerobot_monitor" "web" {
+  friendly_name         = "My Monitor"
+  type                  = "http"
+  url                   = "http://example.com"
+  ignore_alert_contacts = true
+}
+
+resource "uptimerobot_monitor_alert_contact" "on_call" {
+  monitor_id       = uptimerobot_monitor.web.id
+  alert_contact_id = "1234567"
+  threshold        = 5
+  recurrence       = 30
+}
@@ -0,0 +1,2 @@
+# Import a status page by its ID
+terraform import uptimerobot_status_page.public 12345
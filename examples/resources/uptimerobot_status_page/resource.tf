@@ -0,0 +1,14 @@
+# Publish a status page for two monitors on a custom domain
+resource "uptimerobot_status_page" "public" {
+  friendly_name = "Example Status"
+  monitor_ids   = [uptimerobot_monitor.web.id, uptimerobot_monitor.api.id]
+  custom_domain = "status.example.com"
+  sort          = "status_down_first"
+}
+
+# Or show every monitor of the account behind a password
+resource "uptimerobot_status_page" "internal" {
+  friendly_name = "Internal Status"
+  all_monitors  = true
+  password      = var.status_page_password
+}
@@ -4,6 +4,8 @@ go 1.15
 
 require (
 	github.com/exileed/uptimerobotapi v1.1.0
+	github.com/google/go-querystring v1.1.0
+	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
 	github.com/hashicorp/terraform-plugin-docs v0.5.1
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.8.0
 )
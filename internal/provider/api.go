@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/exileed/uptimerobotapi"
+	"github.com/google/go-querystring/query"
+)
+
+// apiBaseURL is the UptimeRobot API endpoint used by apiRequest.
+var apiBaseURL = "https://api.uptimerobot.com/v2/"
+
+// apiRequest calls an UptimeRobot API method directly. It is used for the
+// endpoints and parameters the uptimerobotapi client does not cover or
+// encodes incorrectly, and returns errors in the same shape as the client so
+// retryTime handles both alike.
+func apiRequest(ctx context.Context, client uptimerobotapi.Client, method string, params interface{}, out interface{}) error {
+	q, err := query.Values(params)
+	if err != nil {
+		return err
+	}
+
+	q.Set("api_key", client.Token)
+	q.Set("format", "json")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiBaseURL+method, strings.NewReader(q.Encode()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return uptimerobotapi.APIError{
+			StatusCode: res.StatusCode,
+			Message:    fmt.Sprintf("HTTP response with status code %d", res.StatusCode),
+		}
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	var status uptimerobotapi.ErrorResponse
+	if err := json.Unmarshal(body, &status); err != nil {
+		return err
+	}
+
+	if status.Stat != uptimerobotapi.StatOk {
+		status.Error.StatusCode = res.StatusCode
+		return status.Error
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+// flexInt decodes the numeric fields the API returns either as numbers, as
+// numeric strings or as empty strings / null when unset.
+type flexInt int
+
+func (i *flexInt) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "" || s == "null" {
+		*i = 0
+		return nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("cannot decode %s as integer: %w", string(b), err)
+	}
+
+	*i = flexInt(v)
+	return nil
+}
+
+// flexString decodes fields the API returns either as a string or as an
+// embedded JSON document, keeping the latter in its raw JSON form.
+type flexString string
+
+func (s *flexString) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*s = ""
+		return nil
+	}
+
+	var v string
+	if err := json.Unmarshal(b, &v); err == nil {
+		*s = flexString(v)
+		return nil
+	}
+
+	*s = flexString(b)
+	return nil
+}
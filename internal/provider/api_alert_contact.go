@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/exileed/uptimerobotapi"
+)
+
+// alertContactParams holds the newAlertContact/editAlertContact parameters.
+// The uptimerobotapi client only sends type, value and friendly_name, so
+// alert contacts with webhook settings are written with apiRequest instead.
+type alertContactParams struct {
+	Id              int     `url:"id,omitempty"`
+	Type            int     `url:"type,omitempty"`
+	FriendlyName    string  `url:"friendly_name,omitempty"`
+	Value           string  `url:"value,omitempty"`
+	HttpMethod      *int    `url:"http_method,omitempty"`
+	PostValue       *string `url:"post_value,omitempty"`
+	SendQueryString *bool   `url:"send_query_string,int,omitempty"`
+	SendJSON        *bool   `url:"send_json,int,omitempty"`
+	SendPostParams  *bool   `url:"send_post_params,int,omitempty"`
+	Status          *int    `url:"status,omitempty"`
+}
+
+type getAlertContactsParams struct {
+	AlertContacts *string `url:"alert_contacts,omitempty"`
+	Offset        int     `url:"offset,omitempty"`
+	Limit         *int    `url:"limit,omitempty"`
+}
+
+type alertContactsResp struct {
+	Stat          string         `json:"stat"`
+	Offset        int            `json:"offset"`
+	Limit         int            `json:"limit"`
+	Total         int            `json:"total"`
+	AlertContacts []alertContact `json:"alert_contacts"`
+}
+
+type alertContactSingleResp struct {
+	Stat         string `json:"stat"`
+	AlertContact struct {
+		Id flexInt `json:"id"`
+	} `json:"alertcontact"`
+}
+
+// alertContact is an alert contact as returned by getAlertContacts,
+// including the webhook settings the uptimerobotapi client does not decode.
+type alertContact struct {
+	Id              string     `json:"id"`
+	FriendlyName    string     `json:"friendly_name"`
+	Type            int        `json:"type"`
+	Status          int        `json:"status"`
+	Value           string     `json:"value"`
+	HttpMethod      flexInt    `json:"http_method"`
+	PostValue       flexString `json:"post_value"`
+	SendQueryString flexInt    `json:"send_query_string"`
+	SendJSON        flexInt    `json:"send_json"`
+	SendPostParams  flexInt    `json:"send_post_params"`
+}
+
+func getAlertContacts(ctx context.Context, client uptimerobotapi.Client, params getAlertContactsParams) (*alertContactsResp, error) {
+	obj := &alertContactsResp{}
+
+	err := apiRequest(ctx, client, "getAlertContacts", params, obj)
+
+	return obj, err
+}
+
+func newAlertContact(ctx context.Context, client uptimerobotapi.Client, params alertContactParams) (*alertContactSingleResp, error) {
+	obj := &alertContactSingleResp{}
+
+	err := apiRequest(ctx, client, "newAlertContact", params, obj)
+
+	return obj, err
+}
+
+func editAlertContact(ctx context.Context, client uptimerobotapi.Client, params alertContactParams) (*alertContactSingleResp, error) {
+	obj := &alertContactSingleResp{}
+
+	err := apiRequest(ctx, client, "editAlertContact", params, obj)
+
+	return obj, err
+}
+
+// alertContactsPageLimit is the largest page getAlertContacts returns.
+const alertContactsPageLimit = 50
+
+// getAllAlertContacts pages through getAlertContacts until every alert
+// contact of the account has been returned.
+func getAllAlertContacts(ctx context.Context, client uptimerobotapi.Client) ([]alertContact, error) {
+	limit := alertContactsPageLimit
+	params := getAlertContactsParams{Limit: &limit}
+
+	var alertContacts []alertContact
+
+	for {
+		page, err := getAlertContacts(ctx, client, params)
+		if err != nil {
+			return nil, err
+		}
+
+		alertContacts = append(alertContacts, page.AlertContacts...)
+		params.Offset += len(page.AlertContacts)
+
+		if len(page.AlertContacts) == 0 || params.Offset >= page.Total {
+			return alertContacts, nil
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/exileed/uptimerobotapi"
+)
+
+// monitorParams holds the newMonitor/editMonitor parameters. The
+// uptimerobotapi client sends keyword_case_type, http_password and
+// http_auth_type under the wrong keys, so monitors are written with
+// apiRequest instead.
+type monitorParams struct {
+	Id                 int     `url:"id,omitempty"`
+	Type               int     `url:"type,omitempty"`
+	FriendlyName       string  `url:"friendly_name,omitempty"`
+	Url                string  `url:"url,omitempty"`
+	SubType            *int    `url:"sub_type,omitempty"`
+	Port               *int    `url:"port,omitempty"`
+	KeywordType        *int    `url:"keyword_type,omitempty"`
+	KeywordCaseType    *int    `url:"keyword_case_type,omitempty"`
+	KeywordValue       *string `url:"keyword_value,omitempty"`
+	Interval           *int    `url:"interval,omitempty"`
+	Timeout            *int    `url:"timeout,omitempty"`
+	HttpUsername       *string `url:"http_username,omitempty"`
+	HttpPassword       *string `url:"http_password,omitempty"`
+	HttpAuthType       *int    `url:"http_auth_type,omitempty"`
+	HttpMethod         *int    `url:"http_method,omitempty"`
+	PostType           *int    `url:"post_type,omitempty"`
+	PostValue          *string `url:"post_value,omitempty"`
+	PostContentType    *int    `url:"post_content_type,omitempty"`
+	AlertContacts      *string `url:"alert_contacts,omitempty"`
+	MWindows           *string `url:"mwindows,omitempty"`
+	CustomHttpHeaders  *string `url:"custom_http_headers,omitempty"`
+	CustomHttpStatuses *string `url:"custom_http_statuses,omitempty"`
+	IgnoreSSLErrors    *bool   `url:"ignore_ssl_errors,int,omitempty"`
+	Status             *int    `url:"status,omitempty"`
+}
+
+// monitorsPageLimit is the largest page getMonitors returns.
+const monitorsPageLimit = 50
+
+type getMonitorsParams struct {
+	Monitors               *string `url:"monitors,omitempty"`
+	AlertContacts          int     `url:"alert_contacts,omitempty"`
+	SSL                    int     `url:"ssl,omitempty"`
+	MWindows               int     `url:"mwindows,omitempty"`
+	CustomHttpHeaders      int     `url:"custom_http_headers,omitempty"`
+	CustomHttpStatuses     int     `url:"custom_http_statuses,omitempty"`
+	Offset                 int     `url:"offset,omitempty"`
+	Limit                  *int    `url:"limit,omitempty"`
+	Search                 *string `url:"search,omitempty"`
+	Types                  *string `url:"types,omitempty"`
+	Statuses               *string `url:"statuses,omitempty"`
+	CustomUptimeRatios     *string `url:"custom_uptime_ratios,omitempty"`
+	CustomUptimeRanges     *string `url:"custom_uptime_ranges,omitempty"`
+	AllTimeUptimeRatio     int     `url:"all_time_uptime_ratio,omitempty"`
+	ResponseTimes          int     `url:"response_times,omitempty"`
+	ResponseTimesAverage   *int    `url:"response_times_average,omitempty"`
+	ResponseTimesStartDate int64   `url:"response_times_start_date,omitempty"`
+	ResponseTimesEndDate   int64   `url:"response_times_end_date,omitempty"`
+}
+
+type monitorsResp struct {
+	Stat       string                    `json:"stat"`
+	Pagination uptimerobotapi.Pagination `json:"pagination"`
+	Monitors   []monitor                 `json:"monitors"`
+}
+
+type monitor struct {
+	Id                 int                                  `json:"id"`
+	FriendlyName       string                               `json:"friendly_name"`
+	Url                string                               `json:"url"`
+	Type               int                                  `json:"type"`
+	SubType            flexInt                              `json:"sub_type"`
+	Port               flexInt                              `json:"port"`
+	KeywordType        flexInt                              `json:"keyword_type"`
+	KeywordCaseType    flexInt                              `json:"keyword_case_type"`
+	KeywordValue       string                               `json:"keyword_value"`
+	HttpUsername       string                               `json:"http_username"`
+	HttpPassword       string                               `json:"http_password"`
+	HttpMethod         flexInt                              `json:"http_method"`
+	PostType           flexInt                              `json:"post_type"`
+	PostValue          flexString                           `json:"post_value"`
+	PostContentType    *flexInt                             `json:"post_content_type"`
+	Interval           int                                  `json:"interval"`
+	Timeout            int                                  `json:"timeout"`
+	Status             int                                  `json:"status"`
+	CustomHttpHeaders  httpHeaders                          `json:"custom_http_headers"`
+	CustomHttpStatuses flexString                           `json:"custom_http_statuses"`
+	AlertContacts      []uptimerobotapi.AlertContactMonitor `json:"alert_contacts"`
+	MWindows           []monitorMWindow                     `json:"mwindows"`
+	SSL                *uptimerobotapi.MonitorSSL           `json:"ssl"`
+	CreateDatetime     flexInt                              `json:"create_datetime"`
+	CustomUptimeRatio  flexString                           `json:"custom_uptime_ratio"`
+	CustomUptimeRanges flexString                           `json:"custom_uptime_ranges"`
+	AllTimeUptimeRatio flexString                           `json:"all_time_uptime_ratio"`
+	ResponseTimes      []monitorResponseTime                `json:"response_times"`
+}
+
+// monitorResponseTime is a single point of the response time series of a
+// monitor, in milliseconds.
+type monitorResponseTime struct {
+	Datetime int64   `json:"datetime"`
+	Value    flexInt `json:"value"`
+}
+
+// monitorMWindow is a maintenance window as listed on a monitor. Only the ID
+// is used, the remaining fields differ between window types.
+type monitorMWindow struct {
+	Id int `json:"id"`
+}
+
+// httpHeaders decodes custom_http_headers, which the API returns as an empty
+// array instead of an empty object when no headers are set.
+type httpHeaders map[string]string
+
+func (h *httpHeaders) UnmarshalJSON(b []byte) error {
+	if string(b) == "[]" || string(b) == "null" {
+		*h = httpHeaders{}
+		return nil
+	}
+
+	var v map[string]string
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	*h = v
+	return nil
+}
+
+func getMonitors(ctx context.Context, client uptimerobotapi.Client, params getMonitorsParams) (*monitorsResp, error) {
+	obj := &monitorsResp{}
+
+	err := apiRequest(ctx, client, "getMonitors", params, obj)
+
+	return obj, err
+}
+
+// getAllMonitors pages through getMonitors until every monitor matching
+// params has been returned.
+func getAllMonitors(ctx context.Context, client uptimerobotapi.Client, params getMonitorsParams) ([]monitor, error) {
+	limit := monitorsPageLimit
+	params.Limit = &limit
+	params.Offset = 0
+
+	var monitors []monitor
+
+	for {
+		page, err := getMonitors(ctx, client, params)
+		if err != nil {
+			return nil, err
+		}
+
+		monitors = append(monitors, page.Monitors...)
+		params.Offset += len(page.Monitors)
+
+		if len(page.Monitors) == 0 || params.Offset >= page.Pagination.Total {
+			return monitors, nil
+		}
+	}
+}
+
+func newMonitor(ctx context.Context, client uptimerobotapi.Client, params monitorParams) (*uptimerobotapi.MonitorsSingResp, error) {
+	obj := &uptimerobotapi.MonitorsSingResp{}
+
+	err := apiRequest(ctx, client, "newMonitor", params, obj)
+
+	return obj, err
+}
+
+func editMonitor(ctx context.Context, client uptimerobotapi.Client, params monitorParams) (*uptimerobotapi.MonitorsSingResp, error) {
+	obj := &uptimerobotapi.MonitorsSingResp{}
+
+	err := apiRequest(ctx, client, "editMonitor", params, obj)
+
+	return obj, err
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/exileed/uptimerobotapi"
+)
+
+// mWindowParams holds the newMWindow/editMWindow parameters. The
+// uptimerobotapi client neither decodes the ID of a new maintenance window
+// nor sends one when editing it, so maintenance windows are written with
+// apiRequest instead.
+type mWindowParams struct {
+	Id           int    `url:"id,omitempty"`
+	Type         int    `url:"type,omitempty"`
+	FriendlyName string `url:"friendly_name"`
+	Value        string `url:"value"`
+	StartTime    string `url:"start_time"`
+	Duration     int    `url:"duration"`
+	Status       *int   `url:"status,omitempty"`
+}
+
+type getMWindowsParams struct {
+	MWindows *string `url:"mwindows,omitempty"`
+}
+
+type mWindowsResp struct {
+	Stat       string                    `json:"stat"`
+	Pagination uptimerobotapi.Pagination `json:"pagination"`
+	MWindows   []mWindow                 `json:"mwindows"`
+}
+
+type mWindowSingleResp struct {
+	Stat    string `json:"stat"`
+	MWindow struct {
+		Id     int     `json:"id"`
+		Status flexInt `json:"status"`
+	} `json:"mwindow"`
+}
+
+type mWindow struct {
+	Id           int        `json:"id"`
+	Type         int        `json:"type"`
+	FriendlyName string     `json:"friendly_name"`
+	StartTime    flexString `json:"start_time"`
+	Duration     int        `json:"duration"`
+	Value        flexString `json:"value"`
+	Status       int        `json:"status"`
+}
+
+func getMWindows(ctx context.Context, client uptimerobotapi.Client, params getMWindowsParams) (*mWindowsResp, error) {
+	obj := &mWindowsResp{}
+
+	err := apiRequest(ctx, client, "getMWindows", params, obj)
+
+	return obj, err
+}
+
+func newMWindow(ctx context.Context, client uptimerobotapi.Client, params mWindowParams) (*mWindowSingleResp, error) {
+	obj := &mWindowSingleResp{}
+
+	err := apiRequest(ctx, client, "newMWindow", params, obj)
+
+	return obj, err
+}
+
+func editMWindow(ctx context.Context, client uptimerobotapi.Client, params mWindowParams) (*mWindowSingleResp, error) {
+	obj := &mWindowSingleResp{}
+
+	err := apiRequest(ctx, client, "editMWindow", params, obj)
+
+	return obj, err
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/exileed/uptimerobotapi"
+)
+
+// pspParams holds the newPSP/editPSP parameters of public status pages,
+// which the uptimerobotapi client does not cover.
+type pspParams struct {
+	Id           int     `url:"id,omitempty"`
+	Type         int     `url:"type,omitempty"`
+	FriendlyName string  `url:"friendly_name"`
+	Monitors     string  `url:"monitors"`
+	CustomDomain *string `url:"custom_domain,omitempty"`
+	Password     *string `url:"password,omitempty"`
+	Sort         *int    `url:"sort,omitempty"`
+	HideURLLinks *bool   `url:"hide_url_links,int,omitempty"`
+	Status       *int    `url:"status,omitempty"`
+}
+
+type getPSPsParams struct {
+	PSPs *string `url:"psps,omitempty"`
+}
+
+type pspsResp struct {
+	Stat       string                    `json:"stat"`
+	Pagination uptimerobotapi.Pagination `json:"pagination"`
+	PSPs       []psp                     `json:"psps"`
+}
+
+type pspSingleResp struct {
+	Stat string `json:"stat"`
+	PSP  struct {
+		Id int `json:"id"`
+	} `json:"psp"`
+}
+
+type deletePSPParams struct {
+	Id int `url:"id"`
+}
+
+type psp struct {
+	Id           int         `json:"id"`
+	FriendlyName string      `json:"friendly_name"`
+	Monitors     pspMonitors `json:"monitors"`
+	CustomDomain string      `json:"custom_domain"`
+	Password     string      `json:"password"`
+	Sort         int         `json:"sort"`
+	HideURLLinks flexInt     `json:"hide_url_links"`
+	Status       int         `json:"status"`
+	StandardURL  string      `json:"standard_url"`
+	CustomURL    string      `json:"custom_url"`
+}
+
+// pspMonitors decodes the monitors of a status page, which the API returns
+// as 0 when the page shows all monitors and otherwise as a list of IDs, a
+// dash separated string of IDs or, for a single monitor, its ID.
+type pspMonitors struct {
+	All bool
+	Ids []int
+}
+
+func (m *pspMonitors) UnmarshalJSON(b []byte) error {
+	var ids []int
+	if err := json.Unmarshal(b, &ids); err == nil {
+		*m = pspMonitors{Ids: ids}
+		return nil
+	}
+
+	s := strings.Trim(string(b), `"`)
+	if s == "" || s == "null" || s == "0" {
+		*m = pspMonitors{All: true}
+		return nil
+	}
+
+	for _, part := range strings.Split(s, "-") {
+		id, err := strconv.Atoi(part)
+		if err != nil {
+			return fmt.Errorf("cannot decode %s as monitor IDs: %w", string(b), err)
+		}
+		ids = append(ids, id)
+	}
+
+	*m = pspMonitors{Ids: ids}
+	return nil
+}
+
+func getPSPs(ctx context.Context, client uptimerobotapi.Client, params getPSPsParams) (*pspsResp, error) {
+	obj := &pspsResp{}
+
+	err := apiRequest(ctx, client, "getPSPs", params, obj)
+
+	return obj, err
+}
+
+func newPSP(ctx context.Context, client uptimerobotapi.Client, params pspParams) (*pspSingleResp, error) {
+	obj := &pspSingleResp{}
+
+	err := apiRequest(ctx, client, "newPSP", params, obj)
+
+	return obj, err
+}
+
+func editPSP(ctx context.Context, client uptimerobotapi.Client, params pspParams) (*pspSingleResp, error) {
+	obj := &pspSingleResp{}
+
+	err := apiRequest(ctx, client, "editPSP", params, obj)
+
+	return obj, err
+}
+
+func deletePSP(ctx context.Context, client uptimerobotapi.Client, id int) (*pspSingleResp, error) {
+	obj := &pspSingleResp{}
+
+	err := apiRequest(ctx, client, "deletePSP", deletePSPParams{Id: id}, obj)
+
+	return obj, err
+}
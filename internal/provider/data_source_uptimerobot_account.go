@@ -14,6 +14,9 @@ func dataSourceAccount() *schema.Resource {
 		Description: "Use this data source to get information about the current UptimeRobot account.",
 
 		ReadContext: dataSourceAccountRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"email":            {Computed: true, Type: schema.TypeString},
@@ -26,16 +29,16 @@ func dataSourceAccount() *schema.Resource {
 	}
 }
 
-func dataSourceAccountRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func dataSourceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(uptimerobotapi.Client)
 
 	var resp *uptimerobotapi.AccountResp
 	var err error
 
-	err = retryTime(func() error {
+	err = retryTime(ctx, func() error {
 		resp, err = client.Account.GetAccountDetails()
 		return err
-	}, timeoutMinutes)
+	}, d.Timeout(schema.TimeoutRead))
 
 	if err != nil {
 		return diag.Errorf(err.Error())
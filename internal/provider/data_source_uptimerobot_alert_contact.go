@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/exileed/uptimerobotapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlertContact() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to look up a single UptimeRobot alert contact by friendly name, type or value.",
+
+		ReadContext: dataSourceAlertContactRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"friendly_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"friendly_name", "type", "value"},
+				Description:  "The friendly name of the alert contact to look up.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mapKeys(alertContactType), false),
+				Description:  "The type of the alert contact to look up.",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The address of the alert contact to look up, e.g. its email address.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the alert contact: `not_activated`, `paused` or `active`.",
+			},
+		},
+	}
+}
+
+func dataSourceAlertContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+
+	friendlyName := d.Get("friendly_name").(string)
+	acType := d.Get("type").(string)
+	value := d.Get("value").(string)
+
+	var alertContacts []alertContact
+	var err error
+
+	err = retryTime(ctx, func() error {
+		alertContacts, err = getAllAlertContacts(ctx, client)
+		return err
+	}, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	var lookup []string
+	if friendlyName != "" {
+		lookup = append(lookup, fmt.Sprintf("friendly_name %q", friendlyName))
+	}
+	if acType != "" {
+		lookup = append(lookup, fmt.Sprintf("type %q", acType))
+	}
+	if value != "" {
+		lookup = append(lookup, fmt.Sprintf("value %q", value))
+	}
+
+	var matches []alertContact
+	for _, ac := range alertContacts {
+		if friendlyName != "" && ac.FriendlyName != friendlyName {
+			continue
+		}
+		if acType != "" && ac.Type != alertContactType[acType] {
+			continue
+		}
+		if value != "" && ac.Value != value {
+			continue
+		}
+		matches = append(matches, ac)
+	}
+
+	switch len(matches) {
+	case 0:
+		return diag.Errorf("no alert contact matches %s", strings.Join(lookup, " and "))
+	case 1:
+	default:
+		candidates := make([]string, len(matches))
+		for i, ac := range matches {
+			candidates[i] = fmt.Sprintf("%s (%s, %s %s)", ac.Id, ac.FriendlyName, intToString(alertContactType, ac.Type), ac.Value)
+		}
+		return diag.Errorf("%s matches %d alert contacts, narrow the lookup down with type or value: %s", strings.Join(lookup, " and "), len(matches), strings.Join(candidates, "; "))
+	}
+
+	ac := matches[0]
+
+	d.SetId(ac.Id)
+	d.Set("friendly_name", ac.FriendlyName)
+	d.Set("type", intToString(alertContactType, ac.Type))
+	d.Set("value", ac.Value)
+	d.Set("status", intToString(alertContactStatus, ac.Status))
+
+	return nil
+}
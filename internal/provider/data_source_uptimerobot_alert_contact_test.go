@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestUptimeRobotDataSourceAlertContact(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAlertContact,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.uptimerobot_alert_contact.test", "id", "uptimerobot_alert_contact.test", "id"),
+					resource.TestCheckResourceAttr("data.uptimerobot_alert_contact.test", "type", "email"),
+					resource.TestCheckResourceAttr("data.uptimerobot_alert_contact.test", "value", "me+datasource@exileed.com"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAlertContact = `
+resource "uptimerobot_alert_contact" "test" {
+  friendly_name = "terraform data source alert contact"
+  type          = "email"
+  value         = "me+datasource@exileed.com"
+}
+
+data "uptimerobot_alert_contact" "test" {
+  friendly_name = uptimerobot_alert_contact.test.friendly_name
+}
+`
+
+func TestDataSourceAlertContactRead(t *testing.T) {
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"stat": "ok", "offset": 0, "limit": 50, "total": 3, "alert_contacts": [
+			{"id": "11", "friendly_name": "On-call", "type": 2, "status": 2, "value": "oncall@example.com"},
+			{"id": "12", "friendly_name": "On-call", "type": 8, "status": 1, "value": "+14155550100"},
+			{"id": "13", "friendly_name": "Ops Slack", "type": 11, "status": 2, "value": "https://hooks.slack.com/services/A"}
+		]}`)
+	})
+
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		id     string
+		err    string
+	}{
+		{name: "friendly name", config: map[string]interface{}{"friendly_name": "Ops Slack"}, id: "13"},
+		{name: "friendly name and type", config: map[string]interface{}{"friendly_name": "On-call", "type": "sms"}, id: "12"},
+		{name: "value", config: map[string]interface{}{"value": "oncall@example.com"}, id: "11"},
+		{name: "no match", config: map[string]interface{}{"type": "telegram"}, err: `no alert contact matches type "telegram"`},
+		{
+			name:   "ambiguous",
+			config: map[string]interface{}{"friendly_name": "On-call"},
+			err:    `friendly_name "On-call" matches 2 alert contacts, narrow the lookup down with type or value: 11 (On-call, email oncall@example.com); 12`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := dataSourceAlertContact().TestResourceData()
+			for k, v := range tc.config {
+				d.Set(k, v)
+			}
+
+			diags := dataSourceAlertContactRead(context.Background(), d, client)
+
+			if tc.err != "" {
+				if !diags.HasError() || !strings.Contains(diags[0].Summary, tc.err) {
+					t.Fatalf("expected error containing %q, got %v", tc.err, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if d.Id() != tc.id {
+				t.Fatalf("expected alert contact %s, got %s", tc.id, d.Id())
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/exileed/uptimerobotapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlertContacts() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to list the UptimeRobot alert contacts of given types and statuses.",
+
+		ReadContext: dataSourceAlertContactsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(mapKeys(alertContactType), false)},
+				Description: "Only list alert contacts of these types.",
+			},
+			"statuses": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(mapKeys(alertContactStatus), false)},
+				Description: "Only list alert contacts in these states: `not_activated`, `paused` or `active`.",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the matching alert contacts.",
+			},
+			"alert_contacts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching alert contacts, ordered by ID.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":            {Computed: true, Type: schema.TypeString},
+						"friendly_name": {Computed: true, Type: schema.TypeString},
+						"type":          {Computed: true, Type: schema.TypeString},
+						"value":         {Computed: true, Type: schema.TypeString},
+						"status":        {Computed: true, Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlertContactsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+
+	var types []string
+	for _, v := range d.Get("types").(*schema.Set).List() {
+		types = append(types, v.(string))
+	}
+
+	var statuses []string
+	for _, v := range d.Get("statuses").(*schema.Set).List() {
+		statuses = append(statuses, v.(string))
+	}
+
+	var alertContacts []alertContact
+	var err error
+
+	err = retryTime(ctx, func() error {
+		alertContacts, err = getAllAlertContacts(ctx, client)
+		return err
+	}, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	sort.Slice(alertContacts, func(i, j int) bool {
+		a, _ := strconv.Atoi(alertContacts[i].Id)
+		b, _ := strconv.Atoi(alertContacts[j].Id)
+		return a < b
+	})
+
+	ids := make([]string, 0, len(alertContacts))
+	rawAlertContacts := make([]map[string]interface{}, 0, len(alertContacts))
+
+	for _, ac := range alertContacts {
+		acType := intToString(alertContactType, ac.Type)
+		acStatus := intToString(alertContactStatus, ac.Status)
+
+		if len(types) > 0 && !stringInSlice(types, acType) {
+			continue
+		}
+		if len(statuses) > 0 && !stringInSlice(statuses, acStatus) {
+			continue
+		}
+
+		ids = append(ids, ac.Id)
+		rawAlertContacts = append(rawAlertContacts, map[string]interface{}{
+			"id":            ac.Id,
+			"friendly_name": ac.FriendlyName,
+			"type":          acType,
+			"value":         ac.Value,
+			"status":        acStatus,
+		})
+	}
+
+	sort.Strings(types)
+	sort.Strings(statuses)
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(types, ",") + "\n" + strings.Join(statuses, ","))))
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.Errorf("error setting ids: %s", err)
+	}
+
+	if err := d.Set("alert_contacts", rawAlertContacts); err != nil {
+		return diag.Errorf("error setting alert_contacts: %s", err)
+	}
+
+	return nil
+}
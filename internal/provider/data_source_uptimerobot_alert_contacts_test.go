@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestUptimeRobotDataSourceAlertContacts(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAlertContacts,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.uptimerobot_alert_contacts.test", "ids.#"),
+					resource.TestCheckResourceAttr("data.uptimerobot_alert_contacts.test", "alert_contacts.0.type", "email"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAlertContacts = `
+resource "uptimerobot_alert_contact" "test" {
+  friendly_name = "terraform data source alert contacts"
+  type          = "email"
+  value         = "me+datasources@exileed.com"
+}
+
+data "uptimerobot_alert_contacts" "test" {
+  types = ["email"]
+
+  depends_on = [uptimerobot_alert_contact.test]
+}
+`
+
+func TestDataSourceAlertContactsRead(t *testing.T) {
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"stat": "ok", "offset": 0, "limit": 50, "total": 4, "alert_contacts": [
+			{"id": "14", "friendly_name": "Oncall SMS", "type": 8, "status": 2, "value": "+14155550100"},
+			{"id": "11", "friendly_name": "On-call", "type": 2, "status": 2, "value": "oncall@example.com"},
+			{"id": "12", "friendly_name": "Holiday SMS", "type": 8, "status": 1, "value": "+14155550101"},
+			{"id": "13", "friendly_name": "New hire", "type": 2, "status": 0, "value": "new@example.com"}
+		]}`)
+	})
+
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		ids    []interface{}
+	}{
+		{name: "all", config: map[string]interface{}{}, ids: []interface{}{"11", "12", "13", "14"}},
+		{name: "types", config: map[string]interface{}{"types": []interface{}{"sms"}}, ids: []interface{}{"12", "14"}},
+		{name: "statuses", config: map[string]interface{}{"statuses": []interface{}{"active"}}, ids: []interface{}{"11", "14"}},
+		{name: "types and statuses", config: map[string]interface{}{"types": []interface{}{"email"}, "statuses": []interface{}{"not_activated"}}, ids: []interface{}{"13"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := dataSourceAlertContacts().TestResourceData()
+			for k, v := range tc.config {
+				d.Set(k, v)
+			}
+
+			if diags := dataSourceAlertContactsRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if ids := d.Get("ids").([]interface{}); !reflect.DeepEqual(ids, tc.ids) {
+				t.Fatalf("expected ids %v, got %v", tc.ids, ids)
+			}
+			if got := d.Get("alert_contacts.0.id"); got != tc.ids[0] {
+				t.Fatalf("expected the first alert contact to be %v, got %v", tc.ids[0], got)
+			}
+		})
+	}
+}
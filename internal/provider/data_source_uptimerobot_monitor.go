@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/exileed/uptimerobotapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMonitor() *schema.Resource {
+	s := dataSourceSchemaFromResourceSchema(resourceMonitorSchema())
+	// The API does not return these, so there is nothing to look up.
+	delete(s, "http_auth_type")
+	delete(s, "http_password")
+	delete(s, "ignore_alert_contacts")
+
+	s["id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"friendly_name", "url"},
+		Description:   "The ID of the monitor to look up.",
+	}
+	s["friendly_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The friendly name of the monitor to look up.",
+	}
+	s["url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The URL of the monitor to look up.",
+	}
+
+	alertContact := s["alert_contact"].Elem.(*schema.Resource).Schema
+	alertContact["type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The type of the alert contact.",
+	}
+	alertContact["value"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The address alerts are sent to.",
+	}
+
+	s["ssl"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The SSL certificate of `https` URLs.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"brand":                 {Computed: true, Type: schema.TypeString, Description: "The certificate authority."},
+				"product":               {Computed: true, Type: schema.TypeString, Description: "The certificate product."},
+				"expires":               {Computed: true, Type: schema.TypeString, Description: "When the certificate expires, as an RFC 3339 timestamp."},
+				"ignore_errors":         {Computed: true, Type: schema.TypeBool, Description: "Whether SSL errors are ignored."},
+				"disable_notifications": {Computed: true, Type: schema.TypeBool, Description: "Whether SSL expiry notifications are disabled."},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Use this data source to look up a single UptimeRobot monitor by ID, friendly name or URL.",
+
+		ReadContext: dataSourceMonitorRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Schema: s,
+	}
+}
+
+func dataSourceMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+
+	id := d.Get("id").(string)
+	friendlyName := d.Get("friendly_name").(string)
+	url := d.Get("url").(string)
+
+	request := getMonitorsParams{
+		AlertContacts:      1,
+		MWindows:           1,
+		SSL:                1,
+		CustomHttpHeaders:  1,
+		CustomHttpStatuses: 1,
+	}
+
+	var lookup string
+	switch {
+	case id != "":
+		request.Monitors = &id
+		lookup = fmt.Sprintf("id %q", id)
+	case url != "":
+		request.Search = &url
+		lookup = fmt.Sprintf("url %q", url)
+	case friendlyName != "":
+		request.Search = &friendlyName
+		lookup = fmt.Sprintf("friendly_name %q", friendlyName)
+	default:
+		return diag.Errorf("one of id, friendly_name or url must be set")
+	}
+
+	var monitors []monitor
+	var err error
+
+	err = retryTime(ctx, func() error {
+		monitors, err = getAllMonitors(ctx, client, request)
+		return err
+	}, d.Timeout(schema.TimeoutRead))
+
+	if err != nil && !isNotFoundError(err) {
+		return diag.Errorf(err.Error())
+	}
+
+	// search matches substrings of names and URLs, so only exact matches of
+	// every configured attribute count.
+	var matches []monitor
+	for _, m := range monitors {
+		if friendlyName != "" && m.FriendlyName != friendlyName {
+			continue
+		}
+		if url != "" && m.Url != url {
+			continue
+		}
+		matches = append(matches, m)
+	}
+
+	switch len(matches) {
+	case 0:
+		return diag.Errorf("no monitor matches %s", lookup)
+	case 1:
+	default:
+		candidates := make([]string, len(matches))
+		for i, m := range matches {
+			candidates[i] = fmt.Sprintf("%d (%s, %s %s)", m.Id, m.FriendlyName, intToString(monitorType, m.Type), m.Url)
+		}
+		return diag.Errorf("%s matches %d monitors, look one of them up by id instead: %s", lookup, len(matches), strings.Join(candidates, "; "))
+	}
+
+	m := matches[0]
+
+	d.SetId(strconv.Itoa(m.Id))
+	d.Set("id", d.Id())
+
+	if err := fillMonitorAttributes(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The data source also reports the type and address of each contact.
+	rawContacts := make([]map[string]interface{}, len(m.AlertContacts))
+	for k, v := range m.AlertContacts {
+		rawContacts[k] = map[string]interface{}{
+			"id":         v.Id,
+			"threshold":  v.Threshold,
+			"recurrence": v.Recurrence,
+			"type":       intToString(alertContactType, v.Type),
+			"value":      v.Value,
+		}
+	}
+	if err := d.Set("alert_contact", rawContacts); err != nil {
+		return diag.Errorf("error setting alert_contact for data source %s: %s", d.Id(), err.Error())
+	}
+
+	rawSSL := []map[string]interface{}{}
+	if m.SSL != nil && m.SSL.Expires > 0 {
+		rawSSL = append(rawSSL, map[string]interface{}{
+			"brand":                 m.SSL.Brand,
+			"product":               m.SSL.Product,
+			"expires":               time.Unix(int64(m.SSL.Expires), 0).UTC().Format(time.RFC3339),
+			"ignore_errors":         m.SSL.IgnoreErrors == 1,
+			"disable_notifications": m.SSL.DisableNotifications == 1,
+		})
+	}
+	if err := d.Set("ssl", rawSSL); err != nil {
+		return diag.Errorf("error setting ssl for data source %s: %s", d.Id(), err.Error())
+	}
+
+	return nil
+}
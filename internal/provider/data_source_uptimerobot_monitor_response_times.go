@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/exileed/uptimerobotapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMonitorResponseTimes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the response times of an UptimeRobot monitor and their average, minimum, maximum and 95th percentile.",
+
+		ReadContext: dataSourceMonitorResponseTimesRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"monitor_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(numericID, "must be a numeric monitor ID"),
+				Description:  "The ID of the monitor.",
+			},
+			"start_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"end_date"},
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "The start of the range as an RFC 3339 timestamp. Without `start_date` and `end_date` the last 24 hours are returned.",
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"start_date"},
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "The end of the range as an RFC 3339 timestamp.",
+			},
+			"average": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Average the response times over windows of this many minutes.",
+			},
+			"response_times": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The response times, oldest first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"datetime": {Computed: true, Type: schema.TypeString, Description: "When the response time was measured, as an RFC 3339 timestamp."},
+						"value":    {Computed: true, Type: schema.TypeInt, Description: "The response time in milliseconds."},
+					},
+				},
+			},
+			"average_response_time": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The average of `response_times` in milliseconds.",
+			},
+			"min_response_time": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The lowest of `response_times` in milliseconds.",
+			},
+			"max_response_time": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The highest of `response_times` in milliseconds.",
+			},
+			"p95_response_time": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The 95th percentile of `response_times` in milliseconds.",
+			},
+		},
+	}
+}
+
+func dataSourceMonitorResponseTimesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+
+	id := d.Get("monitor_id").(string)
+
+	request := getMonitorsParams{
+		Monitors:      &id,
+		ResponseTimes: 1,
+	}
+
+	if v, ok := d.GetOk("average"); ok {
+		average := v.(int)
+		request.ResponseTimesAverage = &average
+	}
+
+	startDate := d.Get("start_date").(string)
+	endDate := d.Get("end_date").(string)
+
+	if startDate != "" && endDate != "" {
+		start, err := time.Parse(time.RFC3339, startDate)
+		if err != nil {
+			return diag.Errorf("start_date: %s", err)
+		}
+
+		end, err := time.Parse(time.RFC3339, endDate)
+		if err != nil {
+			return diag.Errorf("end_date: %s", err)
+		}
+
+		if !start.Before(end) {
+			return diag.Errorf("start_date: %s is not before end_date %s", startDate, endDate)
+		}
+
+		request.ResponseTimesStartDate = start.Unix()
+		request.ResponseTimesEndDate = end.Unix()
+	}
+
+	var m *monitorsResp
+	var err error
+
+	err = retryTime(ctx, func() error {
+		m, err = getMonitors(ctx, client, request)
+		return err
+	}, d.Timeout(schema.TimeoutRead))
+
+	if isNotFoundError(err) || (err == nil && len(m.Monitors) == 0) {
+		return diag.Errorf("monitor %s not found", id)
+	}
+
+	// The API rejects ranges the account plan does not cover with an error
+	// on one of the response_times parameters.
+	if err != nil && strings.Contains(err.Error(), "response_times") {
+		summary := "The account plan does not allow the requested response times"
+		if startDate != "" && endDate != "" {
+			summary = fmt.Sprintf("The account plan does not allow response times from %s to %s", startDate, endDate)
+		}
+
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   fmt.Sprintf("UptimeRobot limits how far apart start_date and end_date may be, 7 days on the free plan. Request a shorter range or upgrade the plan.\n\n%s", err),
+		}}
+	}
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	series := m.Monitors[0].ResponseTimes
+	sort.Slice(series, func(i, j int) bool { return series[i].Datetime < series[j].Datetime })
+
+	values := make([]int, len(series))
+	rawSeries := make([]map[string]interface{}, len(series))
+
+	for i, v := range series {
+		values[i] = int(v.Value)
+		rawSeries[i] = map[string]interface{}{
+			"datetime": time.Unix(v.Datetime, 0).UTC().Format(time.RFC3339),
+			"value":    int(v.Value),
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(fmt.Sprintf("%s|%s|%s|%d", id, startDate, endDate, d.Get("average").(int)))))
+
+	if err := d.Set("response_times", rawSeries); err != nil {
+		return diag.Errorf("error setting response_times: %s", err)
+	}
+
+	stats := responseTimeStats(values)
+
+	d.Set("average_response_time", stats.average)
+	d.Set("min_response_time", stats.min)
+	d.Set("max_response_time", stats.max)
+	d.Set("p95_response_time", stats.p95)
+
+	return nil
+}
+
+type responseTimeSummary struct {
+	average float64
+	min     int
+	max     int
+	p95     int
+}
+
+// responseTimeStats summarises a response time series. The percentile uses
+// the nearest-rank method, so it is always one of the measured values.
+func responseTimeStats(values []int) responseTimeSummary {
+	if len(values) == 0 {
+		return responseTimeSummary{}
+	}
+
+	sorted := make([]int, len(values))
+	copy(sorted, values)
+	sort.Ints(sorted)
+
+	sum := 0
+	for _, v := range sorted {
+		sum += v
+	}
+
+	rank := int(math.Ceil(0.95*float64(len(sorted)))) - 1
+
+	return responseTimeSummary{
+		average: float64(sum) / float64(len(sorted)),
+		min:     sorted[0],
+		max:     sorted[len(sorted)-1],
+		p95:     sorted[rank],
+	}
+}
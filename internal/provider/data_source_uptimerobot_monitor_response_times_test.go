@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestUptimeRobotDataSourceMonitorResponseTimes(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMonitorResponseTimes,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.uptimerobot_monitor_response_times.test", "monitor_id", "uptimerobot_monitor.test", "id"),
+					resource.TestCheckResourceAttrSet("data.uptimerobot_monitor_response_times.test", "response_times.#"),
+					resource.TestCheckResourceAttrSet("data.uptimerobot_monitor_response_times.test", "p95_response_time"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceMonitorResponseTimes = `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "terraform response times data source"
+  type          = "http"
+  url           = "https://example.com"
+}
+
+data "uptimerobot_monitor_response_times" "test" {
+  monitor_id = uptimerobot_monitor.test.id
+  average    = 30
+}
+`
+
+func TestDataSourceMonitorResponseTimesRead(t *testing.T) {
+	var sent map[string]string
+
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		sent = map[string]string{
+			"monitors":                  r.PostForm.Get("monitors"),
+			"response_times":            r.PostForm.Get("response_times"),
+			"response_times_average":    r.PostForm.Get("response_times_average"),
+			"response_times_start_date": r.PostForm.Get("response_times_start_date"),
+			"response_times_end_date":   r.PostForm.Get("response_times_end_date"),
+		}
+
+		// The API lists the newest response time first.
+		fmt.Fprint(w, `{"stat": "ok", "pagination": {"offset": 0, "limit": 50, "total": 1}, "monitors": [
+			{"id": 11, "friendly_name": "Shop", "type": 1, "response_times": [
+				{"datetime": 1609462800, "value": 400},
+				{"datetime": 1609461000, "value": 250},
+				{"datetime": 1609459200, "value": 150}
+			]}
+		]}`)
+	})
+
+	d := dataSourceMonitorResponseTimes().TestResourceData()
+	d.Set("monitor_id", "11")
+	d.Set("start_date", "2021-01-01T00:00:00Z")
+	d.Set("end_date", "2021-01-02T00:00:00+02:00")
+	d.Set("average", 30)
+
+	if diags := dataSourceMonitorResponseTimesRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expectedSent := map[string]string{
+		"monitors":                  "11",
+		"response_times":            "1",
+		"response_times_average":    "30",
+		"response_times_start_date": "1609459200",
+		"response_times_end_date":   "1609538400",
+	}
+	if !reflect.DeepEqual(sent, expectedSent) {
+		t.Fatalf("expected request %v, got %v", expectedSent, sent)
+	}
+
+	for k, v := range map[string]string{
+		"response_times.#":          "3",
+		"response_times.0.datetime": "2021-01-01T00:00:00Z",
+		"response_times.0.value":    "150",
+		"response_times.2.value":    "400",
+		"min_response_time":         "150",
+		"max_response_time":         "400",
+		"p95_response_time":         "400",
+	} {
+		if got := fmt.Sprint(d.Get(k)); got != v {
+			t.Errorf("expected %s=%q, got %q", k, v, got)
+		}
+	}
+
+	if got := d.Get("average_response_time").(float64); got < 266.66 || got > 266.67 {
+		t.Errorf("expected average_response_time=266.67, got %v", got)
+	}
+}
+
+func TestDataSourceMonitorResponseTimesReadErrors(t *testing.T) {
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"stat": "fail", "error": {"type": "invalid_parameter", "parameter_name": "response_times_start_date",
+			"message": "response_times_start_date and response_times_end_date can't have more than 7 days difference."}}`)
+	})
+
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		err    string
+	}{
+		{
+			name:   "reversed range",
+			config: map[string]interface{}{"start_date": "2021-01-08T00:00:00Z", "end_date": "2021-01-01T00:00:00Z"},
+			err:    "start_date: 2021-01-08T00:00:00Z is not before end_date 2021-01-01T00:00:00Z",
+		},
+		{
+			name:   "plan limit",
+			config: map[string]interface{}{"start_date": "2021-01-01T00:00:00Z", "end_date": "2021-02-01T00:00:00Z"},
+			err:    "The account plan does not allow response times from 2021-01-01T00:00:00Z to 2021-02-01T00:00:00Z",
+		},
+		{
+			name:   "plan limit without range",
+			config: map[string]interface{}{"average": 30},
+			err:    "The account plan does not allow the requested response times",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := dataSourceMonitorResponseTimes().TestResourceData()
+			d.Set("monitor_id", "11")
+			for k, v := range tc.config {
+				d.Set(k, v)
+			}
+
+			diags := dataSourceMonitorResponseTimesRead(context.Background(), d, client)
+
+			if !diags.HasError() || diags[0].Summary != tc.err {
+				t.Fatalf("expected error %q, got %v", tc.err, diags)
+			}
+		})
+	}
+}
+
+func TestResponseTimeStats(t *testing.T) {
+	cases := []struct {
+		values   []int
+		expected responseTimeSummary
+	}{
+		{nil, responseTimeSummary{}},
+		{[]int{120}, responseTimeSummary{average: 120, min: 120, max: 120, p95: 120}},
+		// With 20 values the 95th percentile is the 19th lowest.
+		{
+			[]int{20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+			responseTimeSummary{average: 10.5, min: 1, max: 20, p95: 19},
+		},
+	}
+
+	for _, tc := range cases {
+		if got := responseTimeStats(tc.values); got != tc.expected {
+			t.Errorf("responseTimeStats(%v): expected %+v, got %+v", tc.values, tc.expected, got)
+		}
+	}
+
+	values := []int{3, 1, 2}
+	responseTimeStats(values)
+	if !reflect.DeepEqual(values, []int{3, 1, 2}) {
+		t.Errorf("responseTimeStats changed its input: %v", values)
+	}
+}
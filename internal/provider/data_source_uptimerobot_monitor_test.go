@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestUptimeRobotDataSourceMonitor(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMonitor,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.uptimerobot_monitor.by_name", "id", "uptimerobot_monitor.test", "id"),
+					resource.TestCheckResourceAttrPair("data.uptimerobot_monitor.by_url", "id", "uptimerobot_monitor.test", "id"),
+					resource.TestCheckResourceAttr("data.uptimerobot_monitor.by_id", "type", "http"),
+					resource.TestCheckResourceAttr("data.uptimerobot_monitor.by_id", "url", "https://example.com/data-source"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceMonitor = `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "terraform data source monitor"
+  type          = "http"
+  url           = "https://example.com/data-source"
+}
+
+data "uptimerobot_monitor" "by_id" {
+  id = uptimerobot_monitor.test.id
+}
+
+data "uptimerobot_monitor" "by_name" {
+  friendly_name = uptimerobot_monitor.test.friendly_name
+}
+
+data "uptimerobot_monitor" "by_url" {
+  url = uptimerobot_monitor.test.url
+}
+`
+
+func TestDataSourceMonitorRead(t *testing.T) {
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"stat": "ok", "pagination": {"offset": 0, "limit": 50, "total": 3}, "monitors": [
+			{"id": 11, "friendly_name": "Shop", "url": "https://shop.example.com", "type": 1, "status": 2,
+			 "alert_contacts": [{"id": "7", "type": 2, "value": "oncall@example.com", "threshold": 0, "recurrence": 0}],
+			 "ssl": {"brand": "Let's Encrypt", "product": "R3", "expires": 1640995200, "ignore_errors": 0, "disable_notifications": 0}},
+			{"id": 12, "friendly_name": "Shop staging", "url": "https://staging.shop.example.com", "type": 1, "status": 2},
+			{"id": 13, "friendly_name": "Shop staging", "url": "https://staging2.shop.example.com", "type": 2, "status": 0}
+		]}`)
+	})
+
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		id     string
+		err    string
+	}{
+		{name: "friendly name", config: map[string]interface{}{"friendly_name": "Shop"}, id: "11"},
+		{name: "url", config: map[string]interface{}{"url": "https://staging.shop.example.com"}, id: "12"},
+		{name: "no match", config: map[string]interface{}{"friendly_name": "Blog"}, err: `no monitor matches friendly_name "Blog"`},
+		{
+			name:   "ambiguous",
+			config: map[string]interface{}{"friendly_name": "Shop staging"},
+			err:    `friendly_name "Shop staging" matches 2 monitors, look one of them up by id instead: 12 (Shop staging, http https://staging.shop.example.com); 13`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := dataSourceMonitor().TestResourceData()
+			for k, v := range tc.config {
+				d.Set(k, v)
+			}
+
+			diags := dataSourceMonitorRead(context.Background(), d, client)
+
+			if tc.err != "" {
+				if !diags.HasError() || !strings.Contains(diags[0].Summary, tc.err) {
+					t.Fatalf("expected error containing %q, got %v", tc.err, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if d.Id() != tc.id {
+				t.Fatalf("expected monitor %s, got %s", tc.id, d.Id())
+			}
+		})
+	}
+
+	d := dataSourceMonitor().TestResourceData()
+	d.Set("friendly_name", "Shop")
+
+	if diags := dataSourceMonitorRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	for k, v := range map[string]string{
+		"alert_contact.#": "1",
+		"ssl.0.brand":     "Let's Encrypt",
+		"ssl.0.expires":   "2022-01-01T00:00:00Z",
+		"status":          "up",
+	} {
+		if got := fmt.Sprint(d.Get(k)); got != v {
+			t.Errorf("expected %s=%q, got %q", k, v, got)
+		}
+	}
+}
+
+// TestDataSourceMonitorFillAttributes checks that every attribute shared with
+// the resource exists in the data source schema, which d.Set would otherwise
+// reject.
+func TestDataSourceMonitorFillAttributes(t *testing.T) {
+	for _, body := range []string{
+		`{"id": 11, "type": 2, "url": "https://example.com", "keyword_type": 2, "keyword_value": "Welcome", "http_method": 3,
+		  "post_type": 2, "post_value": "{}", "post_content_type": 1, "http_password": "secret", "ssl": {"ignore_errors": 1},
+		  "custom_http_headers": {"X-Tenant": "terraform"}, "custom_http_statuses": "404:1", "mwindows": [{"id": 3}]}`,
+		`{"id": 12, "type": 5, "url": "https://heartbeat.uptimerobot.com/abc"}`,
+	} {
+		var m monitor
+		if err := json.Unmarshal([]byte(body), &m); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		d := dataSourceMonitor().TestResourceData()
+		if err := fillMonitorAttributes(d, m); err != nil {
+			t.Fatalf("monitor %d: err: %s", m.Id, err)
+		}
+	}
+}
@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/exileed/uptimerobotapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMonitorUptime() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the uptime ratios of one or more UptimeRobot monitors, e.g. for SLA reports.",
+
+		ReadContext: dataSourceMonitorUptimeRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"monitor_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"monitor_id", "monitor_ids"},
+				ValidateFunc: validation.StringMatch(numericID, "must be a numeric monitor ID"),
+				Description:  "The ID of the monitor. Conflicts with `monitor_ids`.",
+			},
+			"monitor_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringMatch(numericID, "must be a numeric monitor ID")},
+				Description: "The IDs of the monitors. Conflicts with `monitor_id`.",
+			},
+			"periods": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntAtLeast(1)},
+				Description: "Periods in days, ending now, to get the uptime ratio of.",
+			},
+			"range": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Explicit date ranges to get the uptime ratio of.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+							Description:  "The start of the range as an RFC 3339 timestamp.",
+						},
+						"end": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+							Description:  "The end of the range as an RFC 3339 timestamp.",
+						},
+					},
+				},
+			},
+			"custom_uptime_ratios": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+				Description: "The uptime percentage of each of `periods`, weighted by the number of checks of each monitor.",
+			},
+			"custom_uptime_ranges": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+				Description: "The uptime percentage of each `range`, weighted by the number of checks of each monitor.",
+			},
+			"all_time_uptime_ratio": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The uptime percentage since each monitor was created, weighted by the number of checks of each monitor.",
+			},
+			"monitors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The uptime ratios of each monitor, ordered by ID.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":                    {Computed: true, Type: schema.TypeString},
+						"friendly_name":         {Computed: true, Type: schema.TypeString},
+						"interval":              {Computed: true, Type: schema.TypeInt},
+						"custom_uptime_ratios":  {Computed: true, Type: schema.TypeList, Elem: &schema.Schema{Type: schema.TypeFloat}},
+						"custom_uptime_ranges":  {Computed: true, Type: schema.TypeList, Elem: &schema.Schema{Type: schema.TypeFloat}},
+						"all_time_uptime_ratio": {Computed: true, Type: schema.TypeFloat},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMonitorUptimeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+
+	var ids []string
+	if v, ok := d.GetOk("monitor_id"); ok {
+		ids = append(ids, v.(string))
+	}
+	for _, v := range d.Get("monitor_ids").(*schema.Set).List() {
+		ids = append(ids, v.(string))
+	}
+	sort.Strings(ids)
+
+	monitorsParam := strings.Join(ids, "-")
+
+	request := getMonitorsParams{
+		Monitors:           &monitorsParam,
+		AllTimeUptimeRatio: 1,
+	}
+
+	periods := d.Get("periods").([]interface{})
+	if len(periods) > 0 {
+		ratios := make([]string, len(periods))
+		for i, v := range periods {
+			ratios[i] = strconv.Itoa(v.(int))
+		}
+		ratiosParam := strings.Join(ratios, "-")
+		request.CustomUptimeRatios = &ratiosParam
+	}
+
+	ranges := d.Get("range").([]interface{})
+	if len(ranges) > 0 {
+		rangesParam, err := encodeUptimeRanges(ranges)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.CustomUptimeRanges = &rangesParam
+	}
+
+	var monitors []monitor
+	var err error
+
+	err = retryTime(ctx, func() error {
+		monitors, err = getAllMonitors(ctx, client, request)
+		return err
+	}, d.Timeout(schema.TimeoutRead))
+
+	if err != nil && !isNotFoundError(err) {
+		return diag.Errorf(err.Error())
+	}
+
+	found := make(map[string]bool, len(monitors))
+	for _, m := range monitors {
+		found[strconv.Itoa(m.Id)] = true
+	}
+	for _, id := range ids {
+		if !found[id] {
+			return diag.Errorf("monitor %s not found", id)
+		}
+	}
+
+	sort.Slice(monitors, func(i, j int) bool { return monitors[i].Id < monitors[j].Id })
+
+	now := time.Now().Unix()
+
+	periodRatios := newWeightedRatios(len(periods))
+	rangeRatios := newWeightedRatios(len(ranges))
+	allTimeRatio := newWeightedRatios(1)
+
+	rawMonitors := make([]map[string]interface{}, len(monitors))
+
+	for i, m := range monitors {
+		mPeriods, err := parseUptimeRatios(string(m.CustomUptimeRatio), len(periods))
+		if err != nil {
+			return diag.Errorf("error reading custom_uptime_ratio of monitor %d: %s", m.Id, err)
+		}
+
+		mRanges, err := parseUptimeRatios(string(m.CustomUptimeRanges), len(ranges))
+		if err != nil {
+			return diag.Errorf("error reading custom_uptime_ranges of monitor %d: %s", m.Id, err)
+		}
+
+		mAllTime, err := parseUptimeRatios(string(m.AllTimeUptimeRatio), 1)
+		if err != nil {
+			return diag.Errorf("error reading all_time_uptime_ratio of monitor %d: %s", m.Id, err)
+		}
+
+		// Every period or range spans the same time for all monitors, so the
+		// number of checks in it only depends on the interval. All-time
+		// ratios also depend on how long a monitor has existed.
+		interval := m.Interval
+		if interval <= 0 {
+			interval = 1
+		}
+		checksPerSecond := 1 / float64(interval)
+		allTimeChecks := checksPerSecond
+		if created := int64(m.CreateDatetime); created > 0 && created < now {
+			allTimeChecks = float64(now-created) * checksPerSecond
+		}
+
+		periodRatios.add(mPeriods, checksPerSecond)
+		rangeRatios.add(mRanges, checksPerSecond)
+		allTimeRatio.add(mAllTime, allTimeChecks)
+
+		rawMonitors[i] = map[string]interface{}{
+			"id":                    strconv.Itoa(m.Id),
+			"friendly_name":         m.FriendlyName,
+			"interval":              m.Interval,
+			"custom_uptime_ratios":  mPeriods,
+			"custom_uptime_ranges":  mRanges,
+			"all_time_uptime_ratio": mAllTime[0],
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(fmt.Sprintf("%s|%s|%s", monitorsParam, stringValue(request.CustomUptimeRatios), stringValue(request.CustomUptimeRanges)))))
+
+	if err := d.Set("custom_uptime_ratios", periodRatios.ratios()); err != nil {
+		return diag.Errorf("error setting custom_uptime_ratios: %s", err)
+	}
+
+	if err := d.Set("custom_uptime_ranges", rangeRatios.ratios()); err != nil {
+		return diag.Errorf("error setting custom_uptime_ranges: %s", err)
+	}
+
+	d.Set("all_time_uptime_ratio", allTimeRatio.ratios()[0])
+
+	if err := d.Set("monitors", rawMonitors); err != nil {
+		return diag.Errorf("error setting monitors: %s", err)
+	}
+
+	return nil
+}
+
+// encodeUptimeRanges converts range blocks to the API's dash separated list
+// of "<start>_<end>" Unix timestamps.
+func encodeUptimeRanges(ranges []interface{}) (string, error) {
+	encoded := make([]string, len(ranges))
+
+	for i, v := range ranges {
+		r := v.(map[string]interface{})
+
+		start, err := time.Parse(time.RFC3339, r["start"].(string))
+		if err != nil {
+			return "", fmt.Errorf("range.%d.start: %s", i, err)
+		}
+
+		end, err := time.Parse(time.RFC3339, r["end"].(string))
+		if err != nil {
+			return "", fmt.Errorf("range.%d.end: %s", i, err)
+		}
+
+		if !start.Before(end) {
+			return "", fmt.Errorf("range.%d: start %s is not before end %s", i, r["start"], r["end"])
+		}
+
+		encoded[i] = fmt.Sprintf("%d_%d", start.Unix(), end.Unix())
+	}
+
+	return strings.Join(encoded, "-"), nil
+}
+
+// parseUptimeRatios decodes the API's dash separated list of uptime
+// percentages, expecting one value per requested period.
+func parseUptimeRatios(s string, count int) ([]float64, error) {
+	ratios := make([]float64, 0, count)
+
+	if count == 0 {
+		return ratios, nil
+	}
+
+	for _, v := range strings.Split(s, "-") {
+		ratio, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode %q as uptime ratio", v)
+		}
+		ratios = append(ratios, ratio)
+	}
+
+	if len(ratios) != count {
+		return nil, fmt.Errorf("expected %d uptime ratios, got %q", count, s)
+	}
+
+	return ratios, nil
+}
+
+// weightedRatios accumulates the mean of uptime ratios across monitors,
+// weighted by the number of checks behind each ratio.
+type weightedRatios struct {
+	sums   []float64
+	weight float64
+}
+
+func newWeightedRatios(count int) *weightedRatios {
+	return &weightedRatios{sums: make([]float64, count)}
+}
+
+func (w *weightedRatios) add(ratios []float64, weight float64) {
+	for i, ratio := range ratios {
+		w.sums[i] += ratio * weight
+	}
+	w.weight += weight
+}
+
+func (w *weightedRatios) ratios() []float64 {
+	result := make([]float64, len(w.sums))
+	if w.weight == 0 {
+		return result
+	}
+
+	for i, sum := range w.sums {
+		result[i] = sum / w.weight
+	}
+	return result
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
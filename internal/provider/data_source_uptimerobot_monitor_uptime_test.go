@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestUptimeRobotDataSourceMonitorUptime(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMonitorUptime,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.uptimerobot_monitor_uptime.test", "custom_uptime_ratios.#", "2"),
+					resource.TestCheckResourceAttr("data.uptimerobot_monitor_uptime.test", "custom_uptime_ranges.#", "1"),
+					resource.TestCheckResourceAttrSet("data.uptimerobot_monitor_uptime.test", "all_time_uptime_ratio"),
+					resource.TestCheckResourceAttrPair("data.uptimerobot_monitor_uptime.test", "monitors.0.id", "uptimerobot_monitor.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceMonitorUptime = `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "terraform uptime data source"
+  type          = "http"
+  url           = "https://example.com"
+}
+
+data "uptimerobot_monitor_uptime" "test" {
+  monitor_id = uptimerobot_monitor.test.id
+  periods    = [7, 30]
+
+  range {
+    start = "2021-01-01T00:00:00Z"
+    end   = "2021-04-01T00:00:00Z"
+  }
+}
+`
+
+func TestDataSourceMonitorUptimeRead(t *testing.T) {
+	var sent map[string]string
+
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		sent = map[string]string{
+			"monitors":              r.PostForm.Get("monitors"),
+			"custom_uptime_ratios":  r.PostForm.Get("custom_uptime_ratios"),
+			"custom_uptime_ranges":  r.PostForm.Get("custom_uptime_ranges"),
+			"all_time_uptime_ratio": r.PostForm.Get("all_time_uptime_ratio"),
+		}
+
+		// Monitor 12 checks five times as often as monitor 11, so it weighs
+		// five times as much.
+		fmt.Fprint(w, `{"stat": "ok", "pagination": {"offset": 0, "limit": 50, "total": 2}, "monitors": [
+			{"id": 12, "friendly_name": "API", "type": 1, "interval": 60, "create_datetime": 1600000000,
+			 "custom_uptime_ratio": "100.000-99.400", "custom_uptime_ranges": "99.940", "all_time_uptime_ratio": "99.970"},
+			{"id": 11, "friendly_name": "Shop", "type": 1, "interval": 300, "create_datetime": 1600000000,
+			 "custom_uptime_ratio": "94.000-100.000", "custom_uptime_ranges": "100.000", "all_time_uptime_ratio": "99.910"}
+		]}`)
+	})
+
+	d := dataSourceMonitorUptime().TestResourceData()
+	d.Set("monitor_ids", []interface{}{"12", "11"})
+	d.Set("periods", []interface{}{7, 30})
+	d.Set("range", []interface{}{map[string]interface{}{"start": "2021-01-01T00:00:00Z", "end": "2021-04-01T00:00:00+02:00"}})
+
+	if diags := dataSourceMonitorUptimeRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expectedSent := map[string]string{
+		"monitors":              "11-12",
+		"custom_uptime_ratios":  "7-30",
+		"custom_uptime_ranges":  "1609459200_1617228000",
+		"all_time_uptime_ratio": "1",
+	}
+	if !reflect.DeepEqual(sent, expectedSent) {
+		t.Fatalf("expected request %v, got %v", expectedSent, sent)
+	}
+
+	for k, v := range map[string]float64{
+		"custom_uptime_ratios.0":            99.0,
+		"custom_uptime_ratios.1":            99.5,
+		"custom_uptime_ranges.0":            99.95,
+		"all_time_uptime_ratio":             99.96,
+		"monitors.0.all_time_uptime_ratio":  99.91,
+		"monitors.1.custom_uptime_ratios.1": 99.4,
+	} {
+		if got := d.Get(k).(float64); math.Abs(got-v) > 1e-9 {
+			t.Errorf("expected %s=%v, got %v", k, v, got)
+		}
+	}
+}
+
+func TestDataSourceMonitorUptimeReadErrors(t *testing.T) {
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"stat": "ok", "pagination": {"offset": 0, "limit": 50, "total": 1}, "monitors": [
+			{"id": 11, "friendly_name": "Shop", "type": 1, "interval": 300, "all_time_uptime_ratio": "99.910"}
+		]}`)
+	})
+
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		err    string
+	}{
+		{
+			name:   "missing monitor",
+			config: map[string]interface{}{"monitor_ids": []interface{}{"11", "13"}},
+			err:    "monitor 13 not found",
+		},
+		{
+			name: "reversed range",
+			config: map[string]interface{}{"monitor_id": "11", "range": []interface{}{
+				map[string]interface{}{"start": "2021-04-01T00:00:00Z", "end": "2021-01-01T00:00:00Z"},
+			}},
+			err: "range.0: start 2021-04-01T00:00:00Z is not before end 2021-01-01T00:00:00Z",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := dataSourceMonitorUptime().TestResourceData()
+			for k, v := range tc.config {
+				d.Set(k, v)
+			}
+
+			diags := dataSourceMonitorUptimeRead(context.Background(), d, client)
+
+			if !diags.HasError() || diags[0].Summary != tc.err {
+				t.Fatalf("expected error %q, got %v", tc.err, diags)
+			}
+		})
+	}
+}
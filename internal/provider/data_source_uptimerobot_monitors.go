@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/exileed/uptimerobotapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMonitors() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to list the UptimeRobot monitors matching a set of filters.",
+
+		ReadContext: dataSourceMonitorsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(mapKeys(monitorType), false)},
+				Description: "Only list monitors of these types.",
+			},
+			"statuses": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(mapKeys(monitorStatusType), false)},
+				Description: "Only list monitors in these states: `paused`, `not_checked_yet`, `up`, `seems_down` or `down`.",
+			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list monitors whose friendly name or URL contains this text.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Only list monitors whose friendly name matches this regular expression.",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the matching monitors.",
+			},
+			"monitors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching monitors, ordered by ID.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":                     {Computed: true, Type: schema.TypeString},
+						"friendly_name":          {Computed: true, Type: schema.TypeString},
+						"url":                    {Computed: true, Type: schema.TypeString},
+						"type":                   {Computed: true, Type: schema.TypeString},
+						"sub_type":               {Computed: true, Type: schema.TypeString},
+						"port":                   {Computed: true, Type: schema.TypeInt},
+						"keyword_type":           {Computed: true, Type: schema.TypeString},
+						"keyword_value":          {Computed: true, Type: schema.TypeString},
+						"interval":               {Computed: true, Type: schema.TypeInt},
+						"timeout":                {Computed: true, Type: schema.TypeInt},
+						"status":                 {Computed: true, Type: schema.TypeString},
+						"paused":                 {Computed: true, Type: schema.TypeBool},
+						"alert_contact_ids":      {Computed: true, Type: schema.TypeList, Elem: &schema.Schema{Type: schema.TypeString}},
+						"maintenance_window_ids": {Computed: true, Type: schema.TypeList, Elem: &schema.Schema{Type: schema.TypeInt}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMonitorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+
+	request := getMonitorsParams{
+		AlertContacts: 1,
+		MWindows:      1,
+	}
+
+	// The API takes dash separated lists of type and status numbers.
+	if v := d.Get("types").(*schema.Set).List(); len(v) > 0 {
+		types := joinInts(monitorFilterValues(v, monitorType), "-")
+		request.Types = &types
+	}
+
+	if v := d.Get("statuses").(*schema.Set).List(); len(v) > 0 {
+		statuses := joinInts(monitorFilterValues(v, monitorStatusType), "-")
+		request.Statuses = &statuses
+	}
+
+	if v, ok := d.GetOk("search"); ok {
+		search := v.(string)
+		request.Search = &search
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		var err error
+		if nameRegex, err = regexp.Compile(v.(string)); err != nil {
+			return diag.Errorf("name_regex: %s", err)
+		}
+	}
+
+	var monitors []monitor
+	var err error
+
+	err = retryTime(ctx, func() error {
+		monitors, err = getAllMonitors(ctx, client, request)
+		return err
+	}, d.Timeout(schema.TimeoutRead))
+
+	if err != nil && !isNotFoundError(err) {
+		return diag.Errorf(err.Error())
+	}
+
+	sort.Slice(monitors, func(i, j int) bool { return monitors[i].Id < monitors[j].Id })
+
+	ids := make([]string, 0, len(monitors))
+	rawMonitors := make([]map[string]interface{}, 0, len(monitors))
+
+	for _, m := range monitors {
+		if nameRegex != nil && !nameRegex.MatchString(m.FriendlyName) {
+			continue
+		}
+
+		ids = append(ids, strconv.Itoa(m.Id))
+		rawMonitors = append(rawMonitors, flattenMonitorSummary(m))
+	}
+
+	d.SetId(dataSourceMonitorsID(request, d.Get("name_regex").(string)))
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.Errorf("error setting ids: %s", err)
+	}
+
+	if err := d.Set("monitors", rawMonitors); err != nil {
+		return diag.Errorf("error setting monitors: %s", err)
+	}
+
+	return nil
+}
+
+// monitorFilterValues maps the names of a types or statuses filter to the
+// numbers the API expects.
+func monitorFilterValues(names []interface{}, values map[string]int) []interface{} {
+	result := make([]interface{}, len(names))
+	for i, name := range names {
+		result[i] = values[name.(string)]
+	}
+	return result
+}
+
+// dataSourceMonitorsID derives a stable ID from the filters of the data
+// source.
+func dataSourceMonitorsID(request getMonitorsParams, nameRegex string) string {
+	var parts []string
+	for _, v := range []*string{request.Types, request.Statuses, request.Search} {
+		if v != nil {
+			parts = append(parts, *v)
+		} else {
+			parts = append(parts, "")
+		}
+	}
+	parts = append(parts, nameRegex)
+
+	return strconv.Itoa(schema.HashString(strings.Join(parts, "\n")))
+}
+
+// flattenMonitorSummary returns the attributes of a monitor listed by the
+// uptimerobot_monitors data source.
+func flattenMonitorSummary(m monitor) map[string]interface{} {
+	mType := intToString(monitorType, m.Type)
+
+	alertContactIds := make([]string, len(m.AlertContacts))
+	for i, ac := range m.AlertContacts {
+		alertContactIds[i] = ac.Id
+	}
+	sort.Strings(alertContactIds)
+
+	mWindowIds := make([]int, len(m.MWindows))
+	for i, w := range m.MWindows {
+		mWindowIds[i] = w.Id
+	}
+	sort.Ints(mWindowIds)
+
+	url := m.Url
+	if mType == "heartbeat" {
+		url = ""
+	}
+
+	result := map[string]interface{}{
+		"id":                     strconv.Itoa(m.Id),
+		"friendly_name":          m.FriendlyName,
+		"url":                    url,
+		"type":                   mType,
+		"sub_type":               intToString(monitorSubType, int(m.SubType)),
+		"port":                   0,
+		"keyword_type":           "",
+		"keyword_value":          "",
+		"interval":               m.Interval,
+		"timeout":                m.Timeout,
+		"status":                 intToString(monitorStatusType, m.Status),
+		"paused":                 m.Status == monitorStatusType["paused"],
+		"alert_contact_ids":      alertContactIds,
+		"maintenance_window_ids": mWindowIds,
+	}
+
+	if result["sub_type"] == "custom" {
+		result["port"] = int(m.Port)
+	}
+
+	if mType == "keyword" {
+		result["keyword_type"] = intToString(monitorKeywordType, int(m.KeywordType))
+		result["keyword_value"] = m.KeywordValue
+	}
+
+	return result
+}
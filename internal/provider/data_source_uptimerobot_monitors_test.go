@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestUptimeRobotDataSourceMonitors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMonitors,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.uptimerobot_monitors.test", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.uptimerobot_monitors.test", "ids.0", "uptimerobot_monitor.test", "id"),
+					resource.TestCheckResourceAttr("data.uptimerobot_monitors.test", "monitors.0.type", "keyword"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceMonitors = `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "terraform-monitors-data-source"
+  type          = "keyword"
+  url           = "https://example.com"
+  keyword_type  = "not_exists"
+  keyword_value = "error"
+}
+
+data "uptimerobot_monitors" "test" {
+  types      = ["keyword"]
+  name_regex = "^terraform-monitors-"
+
+  depends_on = [uptimerobot_monitor.test]
+}
+`
+
+func TestDataSourceMonitorsRead(t *testing.T) {
+	var requests []string
+
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		requests = append(requests, fmt.Sprintf("types=%s statuses=%s offset=%s", r.PostForm.Get("types"), r.PostForm.Get("statuses"), r.PostForm.Get("offset")))
+
+		// Two pages of two monitors each.
+		switch r.PostForm.Get("offset") {
+		case "":
+			fmt.Fprint(w, `{"stat": "ok", "pagination": {"offset": 0, "limit": 2, "total": 4}, "monitors": [
+				{"id": 14, "friendly_name": "prod-shop", "url": "https://shop.example.com", "type": 2, "status": 9, "keyword_type": 2, "keyword_value": "error"},
+				{"id": 11, "friendly_name": "staging-shop", "url": "https://staging.shop.example.com", "type": 2, "status": 9}
+			]}`)
+		case "2":
+			fmt.Fprint(w, `{"stat": "ok", "pagination": {"offset": 2, "limit": 2, "total": 4}, "monitors": [
+				{"id": 12, "friendly_name": "prod-api", "url": "https://api.example.com", "type": 2, "status": 9,
+				 "alert_contacts": [{"id": "8"}, {"id": "7"}]},
+				{"id": 13, "friendly_name": "prod-blog", "url": "https://blog.example.com", "type": 2, "status": 9}
+			]}`)
+		default:
+			t.Fatalf("unexpected offset %s", r.PostForm.Get("offset"))
+		}
+	})
+
+	d := dataSourceMonitors().TestResourceData()
+	d.Set("types", []interface{}{"keyword"})
+	d.Set("statuses", []interface{}{"down", "seems_down"})
+	d.Set("name_regex", "^prod-")
+
+	if diags := dataSourceMonitorsRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expectedRequests := []string{"types=2 statuses=8-9 offset=", "types=2 statuses=8-9 offset=2"}
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Fatalf("expected requests %v, got %v", expectedRequests, requests)
+	}
+
+	if ids := d.Get("ids").([]interface{}); !reflect.DeepEqual(ids, []interface{}{"12", "13", "14"}) {
+		t.Fatalf("expected ids [12 13 14], got %v", ids)
+	}
+
+	for k, v := range map[string]string{
+		"monitors.0.friendly_name":       "prod-api",
+		"monitors.0.alert_contact_ids.0": "7",
+		"monitors.2.keyword_type":        "not_exists",
+		"monitors.2.status":              "down",
+	} {
+		if got := fmt.Sprint(d.Get(k)); got != v {
+			t.Errorf("expected %s=%q, got %q", k, v, got)
+		}
+	}
+}
@@ -23,11 +23,20 @@ func Provider(version string) *schema.Provider {
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"uptimerobot_account": dataSourceAccount(),
+			"uptimerobot_account":                dataSourceAccount(),
+			"uptimerobot_alert_contact":          dataSourceAlertContact(),
+			"uptimerobot_alert_contacts":         dataSourceAlertContacts(),
+			"uptimerobot_monitor":                dataSourceMonitor(),
+			"uptimerobot_monitor_response_times": dataSourceMonitorResponseTimes(),
+			"uptimerobot_monitor_uptime":         dataSourceMonitorUptime(),
+			"uptimerobot_monitors":               dataSourceMonitors(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"uptimerobot_alert_contact": resourceAlertContact(),
-			"uptimerobot_monitor":       resourceMonitor(),
+			"uptimerobot_alert_contact":         resourceAlertContact(),
+			"uptimerobot_maintenance_window":    resourceMaintenanceWindow(),
+			"uptimerobot_monitor":               resourceMonitor(),
+			"uptimerobot_monitor_alert_contact": resourceMonitorAlertContact(),
+			"uptimerobot_status_page":           resourceStatusPage(),
 		},
 	}
 	p.ConfigureContextFunc = configure(version, p)
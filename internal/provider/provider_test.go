@@ -1,10 +1,19 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"testing"
 
+	"github.com/exileed/uptimerobotapi"
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 var (
@@ -36,3 +45,73 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("UPTIMEROBOT_API_KEY must be set for acceptance tests")
 	}
 }
+
+// testAPIClient returns a client whose requests, both through the
+// uptimerobotapi client and through apiRequest, are served by handler.
+func testAPIClient(t *testing.T, handler http.HandlerFunc) uptimerobotapi.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	baseURL := apiBaseURL
+	apiBaseURL = server.URL + "/v2/"
+	t.Cleanup(func() { apiBaseURL = baseURL })
+
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	client := uptimerobotapi.NewClientWithConfig(&uptimerobotapi.ClientConfig{
+		APIToken:   "test",
+		HTTPClient: &http.Client{Transport: testRewriteTransport{target: target}},
+	})
+
+	return *client
+}
+
+// testApply plans config against state and applies the plan, as Terraform
+// does for an update, and returns the new state.
+func testApply(t *testing.T, r *schema.Resource, state *terraform.InstanceState, config map[string]interface{}, meta interface{}) *terraform.InstanceState {
+	t.Helper()
+
+	state.RawConfig = testRawConfig(t, r, config)
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	return newState
+}
+
+// testRawConfig converts config to the raw configuration Terraform sends
+// with a plan, which the legacy Diff harness does not build by itself.
+func testRawConfig(t *testing.T, r *schema.Resource, config map[string]interface{}) cty.Value {
+	b, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	raw, err := ctyjson.Unmarshal(b, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return raw
+}
+
+type testRewriteTransport struct {
+	target *url.URL
+}
+
+func (rt testRewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = rt.target.Scheme
+	req.URL.Host = rt.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
@@ -2,7 +2,14 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/mail"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/exileed/uptimerobotapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -30,6 +37,34 @@ var alertContactType = map[string]int{
 	"discord":    23,
 }
 
+var alertContactWebhookHTTPMethod = map[string]int{
+	"get":  monitorHTTPMethodType["get"],
+	"post": monitorHTTPMethodType["post"],
+}
+
+// alertContactWebhookContentMode lists how a webhook sends its payload: as
+// query string parameters, as a JSON body or as form-encoded POST parameters.
+var alertContactWebhookContentMode = []string{"query_string", "json", "post_params"}
+
+// alertContactValueFormats checks value per alert contact type at plan time.
+// Types without an entry accept any value.
+var alertContactValueFormats = map[string]func(string) error{
+	"email":     validateAlertContactEmail,
+	"sms":       validateAlertContactPhone,
+	"phone":     validateAlertContactPhone,
+	"webhook":   validateAlertContactHTTPS,
+	"slack":     validateAlertContactHTTPS,
+	"teams":     validateAlertContactHTTPS,
+	"discord":   validateAlertContactHTTPS,
+	"hangouts":  validateAlertContactHTTPS,
+	"pushover":  validateAlertContactKey(regexp.MustCompile(`^[A-Za-z0-9]{30}$`), "a 30 character Pushover user key"),
+	"pagerduty": validateAlertContactKey(regexp.MustCompile(`^[A-Za-z0-9]{32}$`), "a 32 character PagerDuty integration key"),
+	"telegram":  validateAlertContactKey(regexp.MustCompile(`^-?[0-9]+$`), "a numeric Telegram chat ID"),
+}
+
+// alertContactPhoneNumber matches E.164 phone numbers.
+var alertContactPhoneNumber = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
 var alertContactStatus = map[string]int{
 	"not_activated": 0,
 	"paused":        1,
@@ -44,9 +79,16 @@ func resourceAlertContact() *schema.Resource {
 		ReadContext:   resourceAlertContactRead,
 		UpdateContext: resourceAlertContactUpdate,
 		DeleteContext: resourceAlertContactDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceAlertContactImport,
 		},
+		CustomizeDiff: resourceAlertContactCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"friendly_name": {
@@ -60,13 +102,63 @@ func resourceAlertContact() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(mapKeys(alertContactType), false),
 			},
 			"value": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: false,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"webhook"},
+				Description:   "The address alerts are sent to: an email address for `email`, an E.164 phone number for `sms` and `phone`, an https:// URL for `webhook`, `slack`, `teams`, `discord` and `hangouts`, the user key for `pushover`, the integration key for `pagerduty` and the chat ID for `telegram`. For `webhook` contacts either this or the `webhook` block sets the URL.",
+			},
+			"webhook": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The request sent by `webhook` contacts.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							Description:  "The https:// URL the webhook calls.",
+						},
+						"http_method": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "post",
+							ValidateFunc: validation.StringInSlice(mapKeys(alertContactWebhookHTTPMethod), false),
+							Description:  "The HTTP method of the request: `get` or `post`.",
+						},
+						"payload": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The payload template. Alert variables such as `*monitorFriendlyName*` or `*alertDetails*` are replaced when the alert is sent.",
+						},
+						"content_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "query_string",
+							ValidateFunc: validation.StringInSlice(alertContactWebhookContentMode, false),
+							Description:  "How the payload is sent: as `query_string` parameters, as a `json` body or as form-encoded `post_params`.",
+						},
+					},
+				},
+			},
+			"paused": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether notifications to the alert contact are paused.",
+			},
+			"activated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the alert contact has been activated. Email contacts stay inactive until the link in the activation email is followed.",
 			},
 			"status": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The observed status of the alert contact: `not_activated`, `paused` or `active`.",
 			},
 		},
 	}
@@ -76,40 +168,86 @@ func resourceAlertContactCreate(ctx context.Context, d *schema.ResourceData, met
 	client := meta.(uptimerobotapi.Client)
 
 	acName := d.Get("friendly_name").(string)
-	acValue := d.Get("value").(string)
 	acType := d.Get("type").(string)
 
-	acTypeStr := strconv.Itoa(alertContactType[acType])
+	params := buildAlertContactParams(d)
+	params.Type = alertContactType[acType]
 
-	params := uptimerobotapi.NewAlertContactParams{TypeContact: acTypeStr, Value: acValue, FriendlyName: acName}
+	if params.Value == "" {
+		if acType == "webhook" {
+			return diag.Errorf("webhook: required for webhook alert contacts unless value sets the URL")
+		}
+		return diag.Errorf("value: required for %s alert contacts", acType)
+	}
 
-	var ac *uptimerobotapi.AlertContactSingleResp
+	var ac *alertContactSingleResp
 	var err error
 
-	err = retryTime(func() error {
-		ac, err = client.AlertContact.NewAlertContact(params)
+	err = retryTime(ctx, func() error {
+		ac, err = newAlertContact(ctx, client, params)
 		return err
-	}, timeoutMinutes)
+	}, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return diag.Errorf(err.Error())
 	}
 
-	idStr := strconv.Itoa(ac.AlertContact.Id)
+	idStr := strconv.Itoa(int(ac.AlertContact.Id))
 
 	d.SetId(idStr)
 
-	getParams := uptimerobotapi.GetAlertContactsParams{
+	getParams := getAlertContactsParams{
 		AlertContacts: &idStr,
 	}
 
-	acs, err := client.AlertContact.GetAlertContacts(getParams)
+	acs, err := getAlertContacts(ctx, client, getParams)
 
-	if acs.Total == 0 {
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	if acs.Total == 0 || len(acs.AlertContacts) == 0 {
 		return diag.Errorf("AlertContact %s not found", acName)
 	}
 
-	fillAlertContact(d, acs.AlertContacts[0])
+	err = pauseAfterCreate(ctx, d, func() error {
+		editParams := alertContactParams{
+			Id:     int(ac.AlertContact.Id),
+			Status: alertContactStatusParam(true),
+		}
+
+		_, err := editAlertContact(ctx, client, editParams)
+		return err
+	})
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	// Read the contact again to pick up the paused status.
+	if d.Get("paused").(bool) {
+		acs, err = getAlertContacts(ctx, client, getParams)
+
+		if err != nil {
+			return diag.Errorf(err.Error())
+		}
+
+		if len(acs.AlertContacts) == 0 {
+			return diag.Errorf("AlertContact %s not found", acName)
+		}
+	}
+
+	if err := fillAlertContact(d, acs.AlertContacts[0]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if acType == "email" && acs.AlertContacts[0].Status == alertContactStatus["not_activated"] {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("AlertContact %s is not activated yet", acName),
+			Detail:   fmt.Sprintf("UptimeRobot sent an activation email to %s. No alerts are sent to the contact until the link in that email is followed.", acs.AlertContacts[0].Value),
+		}}
+	}
 
 	return nil
 }
@@ -118,29 +256,33 @@ func resourceAlertContactRead(ctx context.Context, d *schema.ResourceData, meta
 	client := meta.(uptimerobotapi.Client)
 	id := d.Id()
 
-	getParams := uptimerobotapi.GetAlertContactsParams{
+	getParams := getAlertContactsParams{
 		AlertContacts: &id,
 	}
 
-	var ac *uptimerobotapi.AlertContactResp
+	var ac *alertContactsResp
 	var err error
 
-	err = retryTime(func() error {
-		ac, err = client.AlertContact.GetAlertContacts(getParams)
+	err = retryTime(ctx, func() error {
+		ac, err = getAlertContacts(ctx, client, getParams)
 		return err
-	}, timeoutMinutes)
+	}, d.Timeout(schema.TimeoutRead))
 
-	if err != nil {
-		return diag.Errorf(err.Error())
+	if isNotFoundError(err) || (err == nil && len(ac.AlertContacts) == 0) {
+		log.Printf("[WARN] AlertContact %s not found, removing from state", id)
+		d.SetId("")
+		return nil
 	}
 
-	if ac.Total == 0 {
-		return diag.Errorf("AlertContact %s not found err", id)
+	if err != nil {
+		return diag.Errorf(err.Error())
 	}
 
 	alertContact := ac.AlertContacts[0]
 
-	fillAlertContact(d, alertContact)
+	if err := fillAlertContact(d, alertContact); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -150,27 +292,29 @@ func resourceAlertContactUpdate(ctx context.Context, d *schema.ResourceData, met
 
 	id := d.Id()
 
-	acName := d.Get("friendly_name").(string)
-	acValue := d.Get("value").(string)
-
 	idStr, err := strconv.Atoi(id)
 
 	if err != nil {
 		return diag.Errorf(err.Error())
 	}
 
-	params := uptimerobotapi.EditAlertContactParams{Id: idStr, Value: &acValue, FriendlyName: &acName}
+	params := buildAlertContactParams(d)
+	params.Id = idStr
 
-	err = retryTime(func() error {
-		_, err = client.AlertContact.EditAlertContact(params)
+	if d.HasChange("paused") {
+		params.Status = alertContactStatusParam(d.Get("paused").(bool))
+	}
+
+	err = retryTime(ctx, func() error {
+		_, err = editAlertContact(ctx, client, params)
 		return err
-	}, timeoutMinutes)
+	}, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		return diag.Errorf(err.Error())
 	}
 
-	return resourceMonitorRead(ctx, d, meta)
+	return resourceAlertContactRead(ctx, d, meta)
 }
 
 func resourceAlertContactDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -184,10 +328,10 @@ func resourceAlertContactDelete(ctx context.Context, d *schema.ResourceData, met
 		return diag.Errorf(err.Error())
 	}
 
-	err = retryTime(func() error {
+	err = retryTime(ctx, func() error {
 		_, err = client.AlertContact.DeleteAlertContact(idStr)
 		return err
-	}, timeoutMinutes)
+	}, d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		return diag.Errorf(err.Error())
@@ -196,9 +340,252 @@ func resourceAlertContactDelete(ctx context.Context, d *schema.ResourceData, met
 	return nil
 }
 
-func fillAlertContact(d *schema.ResourceData, ac uptimerobotapi.AlertContact) {
+// resourceAlertContactImport accepts a numeric alert contact ID,
+// "name=<friendly name>" or "<type>:<value>", which are resolved to the ID of
+// the single alert contact they match.
+func resourceAlertContactImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+
+	if _, err := strconv.Atoi(importID); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	var match func(alertContact) bool
+
+	if strings.HasPrefix(importID, "name=") {
+		name := strings.TrimPrefix(importID, "name=")
+		match = func(ac alertContact) bool { return ac.FriendlyName == name }
+	} else {
+		parts := strings.SplitN(importID, ":", 2)
+		acType, ok := alertContactType[parts[0]]
+		if len(parts) != 2 || !ok || parts[1] == "" {
+			return nil, fmt.Errorf("unexpected import ID %q, expected an alert contact ID, name=<friendly name> or <type>:<value>", importID)
+		}
+		value := parts[1]
+		match = func(ac alertContact) bool { return ac.Type == acType && ac.Value == value }
+	}
+
+	client := meta.(uptimerobotapi.Client)
+
+	var alertContacts []alertContact
+	var err error
+
+	err = retryTime(ctx, func() error {
+		alertContacts, err = getAllAlertContacts(ctx, client)
+		return err
+	}, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []alertContact
+	for _, ac := range alertContacts {
+		if match(ac) {
+			matches = append(matches, ac)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no alert contact matches import ID %q", importID)
+	case 1:
+		d.SetId(matches[0].Id)
+		return []*schema.ResourceData{d}, nil
+	}
+
+	candidates := make([]string, len(matches))
+	for i, ac := range matches {
+		candidates[i] = fmt.Sprintf("%s (%s, %s %s)", ac.Id, ac.FriendlyName, intToString(alertContactType, ac.Type), ac.Value)
+	}
+
+	return nil, fmt.Errorf("import ID %q matches %d alert contacts, import one of them by ID instead: %s", importID, len(matches), strings.Join(candidates, "; "))
+}
+
+// resourceAlertContactCustomizeDiff checks value and the webhook block against
+// the contact type at plan time.
+func resourceAlertContactCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	acType := d.Get("type").(string)
+
+	if d.HasChange("webhook") {
+		if err := d.SetNewComputed("value"); err != nil {
+			return err
+		}
+	} else if validate, ok := alertContactValueFormats[acType]; ok && d.NewValueKnown("value") {
+		if value := d.Get("value").(string); value != "" {
+			if err := validate(value); err != nil {
+				return fmt.Errorf("value: %s alert contacts take %s", acType, err)
+			}
+		}
+	}
+
+	// value is computed from the webhook URL, so it is only required of the
+	// other types, which reject the webhook block below. Whether a webhook
+	// contact has a URL at all is checked on create.
+	valueConfigured, ok := configuredAttr(d.GetRawConfig(), "value")
+	webhookConfigured, _ := configuredAttr(d.GetRawConfig(), "webhook")
+	if ok && !valueConfigured && !webhookConfigured && acType != "webhook" {
+		return fmt.Errorf("value: required for %s alert contacts", acType)
+	}
+
+	if !d.NewValueKnown("webhook") {
+		return nil
+	}
+
+	webhooks := d.Get("webhook").([]interface{})
+	if len(webhooks) == 0 || webhooks[0] == nil {
+		return nil
+	}
+
+	if acType != "webhook" {
+		return fmt.Errorf("webhook: only valid for webhook alert contacts, got type %q", acType)
+	}
+
+	webhook := webhooks[0].(map[string]interface{})
+
+	if d.NewValueKnown("webhook.0.url") {
+		if err := validateAlertContactHTTPS(webhook["url"].(string)); err != nil {
+			return fmt.Errorf("webhook.0.url: webhook alert contacts take %s", err)
+		}
+	}
+
+	method := webhook["http_method"].(string)
+	mode := webhook["content_mode"].(string)
+	payload := webhook["payload"].(string)
+
+	if method == "get" && mode != "query_string" {
+		return fmt.Errorf("webhook.0.content_mode: %s sends a request body, which requires http_method \"post\"", mode)
+	}
+
+	if mode == "json" && payload != "" && d.NewValueKnown("webhook.0.payload") {
+		var v interface{}
+		if err := json.Unmarshal([]byte(payload), &v); err != nil {
+			return fmt.Errorf("webhook.0.payload: json content_mode requires a JSON payload, quote alert variables such as \"*monitorFriendlyName*\": %s", err)
+		}
+	}
+
+	return nil
+}
+
+func validateAlertContactEmail(value string) error {
+	addr, err := mail.ParseAddress(value)
+	if err != nil || addr.Address != value {
+		return fmt.Errorf("an email address such as oncall@example.com, got %q", value)
+	}
+	return nil
+}
+
+func validateAlertContactPhone(value string) error {
+	if !alertContactPhoneNumber.MatchString(value) {
+		return fmt.Errorf("an E.164 phone number such as +14155550100, got %q", value)
+	}
+	return nil
+}
+
+func validateAlertContactHTTPS(value string) error {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("an https:// URL, got %q", value)
+	}
+	return nil
+}
+
+func validateAlertContactKey(format *regexp.Regexp, expected string) func(string) error {
+	return func(value string) error {
+		if !format.MatchString(value) {
+			return fmt.Errorf("%s, got %q", expected, value)
+		}
+		return nil
+	}
+}
+
+// buildAlertContactParams collects the parameters shared by newAlertContact
+// and editAlertContact from the resource configuration.
+func buildAlertContactParams(d *schema.ResourceData) alertContactParams {
+	params := alertContactParams{
+		FriendlyName: d.Get("friendly_name").(string),
+		Value:        d.Get("value").(string),
+	}
+
+	// webhook is computed from value, so the block kept in state must not
+	// override a new value. Without the raw configuration the block is used
+	// unless value changed on its own.
+	configured, ok := configuredAttr(d.GetRawConfig(), "webhook")
+	if !ok {
+		configured = params.Value == "" || (d.HasChange("webhook") && !d.HasChange("value"))
+	}
+
+	webhooks := d.Get("webhook").([]interface{})
+	if !configured || d.Get("type").(string) != "webhook" || len(webhooks) == 0 || webhooks[0] == nil {
+		return params
+	}
+
+	webhook := webhooks[0].(map[string]interface{})
+	mode := webhook["content_mode"].(string)
+
+	httpMethod := alertContactWebhookHTTPMethod[webhook["http_method"].(string)]
+	payload := webhook["payload"].(string)
+	sendQueryString := mode == "query_string"
+	sendJSON := mode == "json"
+	sendPostParams := mode == "post_params"
+
+	params.Value = webhook["url"].(string)
+	params.HttpMethod = &httpMethod
+	params.PostValue = &payload
+	params.SendQueryString = &sendQueryString
+	params.SendJSON = &sendJSON
+	params.SendPostParams = &sendPostParams
+
+	return params
+}
+
+// alertContactStatusParam returns the editAlertContact status that pauses or
+// resumes an alert contact.
+func alertContactStatusParam(paused bool) *int {
+	status := alertContactStatus["active"]
+	if paused {
+		status = alertContactStatus["paused"]
+	}
+	return &status
+}
+
+func fillAlertContact(d *schema.ResourceData, ac alertContact) error {
+	acType := intToString(alertContactType, ac.Type)
+
 	d.Set("friendly_name", ac.FriendlyName)
 	d.Set("value", ac.Value)
-	d.Set("type", intToString(alertContactType, ac.Type))
+	d.Set("type", acType)
+	d.Set("paused", ac.Status == alertContactStatus["paused"])
+	d.Set("activated", ac.Status != alertContactStatus["not_activated"])
 	d.Set("status", intToString(alertContactStatus, ac.Status))
+
+	var webhooks []interface{}
+
+	if acType == "webhook" {
+		mode := "query_string"
+		switch {
+		case ac.SendJSON == 1:
+			mode = "json"
+		case ac.SendPostParams == 1:
+			mode = "post_params"
+		}
+
+		method := intToString(alertContactWebhookHTTPMethod, int(ac.HttpMethod))
+		if method == "" {
+			method = "post"
+		}
+
+		webhooks = append(webhooks, map[string]interface{}{
+			"url":          ac.Value,
+			"http_method":  method,
+			"payload":      string(ac.PostValue),
+			"content_mode": mode,
+		})
+	}
+
+	if err := d.Set("webhook", webhooks); err != nil {
+		return fmt.Errorf("error setting webhook for resource %s: %s", d.Id(), err.Error())
+	}
+
+	return nil
 }
@@ -1,9 +1,17 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestUptimeRobotResourceAlertContact(t *testing.T) {
@@ -23,6 +31,393 @@ func TestUptimeRobotResourceAlertContact(t *testing.T) {
 	})
 }
 
+func TestUptimeRobotResourceAlertContactWebhook(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAlertContactWebhook,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_alert_contact.test", "type", "webhook"),
+					resource.TestCheckResourceAttr("uptimerobot_alert_contact.test", "value", "https://example.com/uptimerobot"),
+					resource.TestCheckResourceAttr("uptimerobot_alert_contact.test", "webhook.0.http_method", "post"),
+					resource.TestCheckResourceAttr("uptimerobot_alert_contact.test", "webhook.0.content_mode", "json"),
+				),
+			},
+			{
+				ResourceName:      "uptimerobot_alert_contact.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceAlertContactReadNotFound(t *testing.T) {
+	cases := map[string]string{
+		"empty list":   `{"stat": "ok", "offset": 0, "limit": 50, "total": 0, "alert_contacts": []}`,
+		"fail payload": `{"stat": "fail", "error": {"type": "not_found", "parameter_name": "alert_contacts", "passed_value": "42", "message": "alert_contact not found."}}`,
+	}
+
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, body)
+			})
+
+			d := resourceAlertContact().TestResourceData()
+			d.SetId("42")
+
+			if diags := resourceAlertContactRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if d.Id() != "" {
+				t.Fatalf("expected the alert contact to be removed from state, got id %q", d.Id())
+			}
+		})
+	}
+}
+
+func TestResourceAlertContactImport(t *testing.T) {
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		// Serve one alert contact per page to exercise pagination.
+		alertContacts := []string{
+			`{"id": "11", "friendly_name": "On-call", "type": 2, "status": 2, "value": "oncall@example.com"}`,
+			`{"id": "12", "friendly_name": "Ops Slack", "type": 11, "status": 2, "value": "https://hooks.slack.com/services/A"}`,
+			`{"id": "13", "friendly_name": "Ops Slack", "type": 11, "status": 2, "value": "https://hooks.slack.com/services/B"}`,
+		}
+		offset, _ := strconv.Atoi(r.Form.Get("offset"))
+		fmt.Fprintf(w, `{"stat": "ok", "offset": %d, "limit": 1, "total": 3, "alert_contacts": [%s]}`, offset, alertContacts[offset])
+	})
+
+	cases := []struct {
+		importID string
+		id       string
+		err      string
+	}{
+		{importID: "42", id: "42"},
+		{importID: "email:oncall@example.com", id: "11"},
+		{importID: "slack:https://hooks.slack.com/services/B", id: "13"},
+		{importID: "name=Ops Slack", err: "matches 2 alert contacts, import one of them by ID instead: 12 (Ops Slack, slack https://hooks.slack.com/services/A); 13"},
+		{importID: "sms:+15550100", err: "no alert contact matches import ID"},
+		{importID: "fax:+15550100", err: "unexpected import ID"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.importID, func(t *testing.T) {
+			d := resourceAlertContact().TestResourceData()
+			d.SetId(tc.importID)
+
+			result, err := resourceAlertContactImport(context.Background(), d, client)
+
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(result) != 1 || result[0].Id() != tc.id {
+				t.Fatalf("expected alert contact %s, got %v", tc.id, result)
+			}
+		})
+	}
+}
+
+func TestResourceAlertContactCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		err    string
+	}{
+		{
+			name:   "email",
+			config: map[string]interface{}{"type": "email", "value": "oncall@example.com"},
+		},
+		{
+			name:   "webhook by value",
+			config: map[string]interface{}{"type": "webhook", "value": "https://example.com/hook?"},
+		},
+		{
+			name: "webhook with json payload",
+			config: map[string]interface{}{"type": "webhook", "webhook": []interface{}{map[string]interface{}{
+				"url": "https://example.com/hook", "content_mode": "json", "payload": `{"monitor": "*monitorFriendlyName*"}`,
+			}}},
+		},
+		{
+			name:   "email with display name",
+			config: map[string]interface{}{"type": "email", "value": "On-call <oncall@example.com>"},
+			err:    `value: email alert contacts take an email address such as oncall@example.com, got "On-call <oncall@example.com>"`,
+		},
+		{
+			name:   "incomplete email",
+			config: map[string]interface{}{"type": "email", "value": "oncall@"},
+			err:    "value: email alert contacts take an email address",
+		},
+		{
+			name:   "sms",
+			config: map[string]interface{}{"type": "sms", "value": "+4915112345678"},
+		},
+		{
+			name:   "sms without country code",
+			config: map[string]interface{}{"type": "sms", "value": "015112345678"},
+			err:    "value: sms alert contacts take an E.164 phone number",
+		},
+		{
+			name:   "slack over http",
+			config: map[string]interface{}{"type": "slack", "value": "http://hooks.slack.com/services/A"},
+			err:    "value: slack alert contacts take an https:// URL",
+		},
+		{
+			name:   "pagerduty",
+			config: map[string]interface{}{"type": "pagerduty", "value": "0123456789abcdef0123456789abcdef"},
+		},
+		{
+			name:   "short pushover key",
+			config: map[string]interface{}{"type": "pushover", "value": "uQiRzpo4DXghDmr9QzzfQu"},
+			err:    "value: pushover alert contacts take a 30 character Pushover user key",
+		},
+		{
+			name:   "telegram username",
+			config: map[string]interface{}{"type": "telegram", "value": "@oncall"},
+			err:    "value: telegram alert contacts take a numeric Telegram chat ID",
+		},
+		{
+			name:   "email without value",
+			config: map[string]interface{}{"type": "email"},
+			err:    "value: required for email alert contacts",
+		},
+		{
+			name:   "webhook without value",
+			config: map[string]interface{}{"type": "webhook"},
+		},
+		{
+			name: "webhook over http",
+			config: map[string]interface{}{"type": "webhook", "webhook": []interface{}{map[string]interface{}{
+				"url": "http://example.com/hook",
+			}}},
+			err: `webhook.0.url: webhook alert contacts take an https:// URL, got "http://example.com/hook"`,
+		},
+		{
+			name: "webhook block on slack",
+			config: map[string]interface{}{"type": "slack", "webhook": []interface{}{map[string]interface{}{
+				"url": "https://example.com/hook",
+			}}},
+			err: `webhook: only valid for webhook alert contacts, got type "slack"`,
+		},
+		{
+			name: "get with json",
+			config: map[string]interface{}{"type": "webhook", "webhook": []interface{}{map[string]interface{}{
+				"url": "https://example.com/hook", "http_method": "get", "content_mode": "json",
+			}}},
+			err: `webhook.0.content_mode: json sends a request body, which requires http_method "post"`,
+		},
+		{
+			name: "json with unquoted variable",
+			config: map[string]interface{}{"type": "webhook", "webhook": []interface{}{map[string]interface{}{
+				"url": "https://example.com/hook", "content_mode": "json", "payload": `{"type": *alertType*}`,
+			}}},
+			err: "webhook.0.payload: json content_mode requires a JSON payload",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]interface{}{"friendly_name": "test"}
+			for k, v := range tc.config {
+				config[k] = v
+			}
+
+			state := &terraform.InstanceState{RawConfig: testRawConfig(t, resourceAlertContact(), config)}
+
+			_, err := resourceAlertContact().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestResourceAlertContactWebhookRoundTrip(t *testing.T) {
+	var sent url.Values
+
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		switch r.URL.Path {
+		case "/v2/newAlertContact":
+			sent = r.PostForm
+			fmt.Fprint(w, `{"stat": "ok", "alertcontact": {"id": 42, "status": 2}}`)
+		case "/v2/getAlertContacts":
+			fmt.Fprint(w, `{"stat": "ok", "offset": 0, "limit": 50, "total": 1, "alert_contacts": [
+				{"id": "42", "friendly_name": "Incident bot", "type": 5, "status": 2, "value": "https://example.com/hook",
+				 "http_method": 3, "post_value": {"monitor": "*monitorFriendlyName*"}, "send_query_string": 0, "send_json": 1, "send_post_params": 0}
+			]}`)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	d := resourceAlertContact().TestResourceData()
+	d.Set("friendly_name", "Incident bot")
+	d.Set("type", "webhook")
+	d.Set("webhook", []interface{}{map[string]interface{}{
+		"url":          "https://example.com/hook",
+		"http_method":  "post",
+		"payload":      `{"monitor": "*monitorFriendlyName*"}`,
+		"content_mode": "json",
+	}})
+
+	if diags := resourceAlertContactCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := url.Values{
+		"type":              {"5"},
+		"friendly_name":     {"Incident bot"},
+		"value":             {"https://example.com/hook"},
+		"http_method":       {"3"},
+		"post_value":        {`{"monitor": "*monitorFriendlyName*"}`},
+		"send_query_string": {"0"},
+		"send_json":         {"1"},
+		"send_post_params":  {"0"},
+	}
+	for k, v := range expected {
+		if sent.Get(k) != v[0] {
+			t.Errorf("expected %s=%q, got %q", k, v[0], sent.Get(k))
+		}
+	}
+
+	for k, v := range map[string]string{
+		"value":                  "https://example.com/hook",
+		"webhook.0.url":          "https://example.com/hook",
+		"webhook.0.http_method":  "post",
+		"webhook.0.payload":      `{"monitor": "*monitorFriendlyName*"}`,
+		"webhook.0.content_mode": "json",
+	} {
+		if got := d.Get(k); got != v {
+			t.Errorf("expected %s=%q, got %q", k, v, got)
+		}
+	}
+}
+
+func TestResourceAlertContactWebhookValueChange(t *testing.T) {
+	var sent url.Values
+
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		switch r.URL.Path {
+		case "/v2/editAlertContact":
+			sent = r.PostForm
+			fmt.Fprint(w, `{"stat": "ok", "alertcontact": {"id": 42}}`)
+		case "/v2/getAlertContacts":
+			fmt.Fprint(w, `{"stat": "ok", "offset": 0, "limit": 50, "total": 1, "alert_contacts": [
+				{"id": "42", "friendly_name": "Incident bot", "type": 5, "status": 2, "value": "https://b.example.com/hook",
+				 "http_method": 3, "post_value": "", "send_query_string": 1, "send_json": 0, "send_post_params": 0}
+			]}`)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	// The webhook block in state was read back from value, not configured.
+	state := &terraform.InstanceState{
+		ID: "42",
+		Attributes: map[string]string{
+			"id":                     "42",
+			"friendly_name":          "Incident bot",
+			"type":                   "webhook",
+			"value":                  "https://a.example.com/hook",
+			"paused":                 "false",
+			"webhook.#":              "1",
+			"webhook.0.url":          "https://a.example.com/hook",
+			"webhook.0.http_method":  "post",
+			"webhook.0.payload":      "",
+			"webhook.0.content_mode": "query_string",
+		},
+	}
+
+	newState := testApply(t, resourceAlertContact(), state, map[string]interface{}{
+		"friendly_name": "Incident bot",
+		"type":          "webhook",
+		"value":         "https://b.example.com/hook",
+	}, client)
+
+	if actual := sent.Get("value"); actual != "https://b.example.com/hook" {
+		t.Fatalf("expected value param https://b.example.com/hook, got %q", actual)
+	}
+	if actual := newState.Attributes["webhook.0.url"]; actual != "https://b.example.com/hook" {
+		t.Fatalf("expected webhook.0.url https://b.example.com/hook, got %q", actual)
+	}
+}
+
+func TestResourceAlertContactCreateNotActivated(t *testing.T) {
+	var statuses []string
+
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		switch r.URL.Path {
+		case "/v2/newAlertContact":
+			fmt.Fprint(w, `{"stat": "ok", "alertcontact": {"id": 42, "status": 0}}`)
+		case "/v2/editAlertContact":
+			statuses = append(statuses, r.PostForm.Get("status"))
+			fmt.Fprint(w, `{"stat": "ok", "alertcontact": {"id": 42}}`)
+		case "/v2/getAlertContacts":
+			fmt.Fprint(w, `{"stat": "ok", "offset": 0, "limit": 50, "total": 1, "alert_contacts": [
+				{"id": "42", "friendly_name": "On-call", "type": 2, "status": 0, "value": "oncall@example.com"}
+			]}`)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	d := resourceAlertContact().TestResourceData()
+	d.Set("friendly_name", "On-call")
+	d.Set("type", "email")
+	d.Set("value", "oncall@example.com")
+	d.Set("paused", true)
+
+	diags := resourceAlertContactCreate(context.Background(), d, client)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Summary, "not activated") {
+		t.Fatalf("expected a not activated warning, got %v", diags)
+	}
+	if len(statuses) != 1 || statuses[0] != "1" {
+		t.Fatalf("expected the contact to be paused with status 1, got %v", statuses)
+	}
+	if d.Get("activated").(bool) {
+		t.Fatalf("expected activated to be false")
+	}
+	if got := d.Get("status").(string); got != "not_activated" {
+		t.Fatalf("expected status not_activated, got %q", got)
+	}
+}
+
 const testAccResourceAlertContact = `
 resource "uptimerobot_alert_contact" "test" {
   friendly_name = "me+test@exileed.com"
@@ -30,3 +425,16 @@ resource "uptimerobot_alert_contact" "test" {
   value = "me+test@exileed.com"
 }
 `
+
+const testAccResourceAlertContactWebhook = `
+resource "uptimerobot_alert_contact" "test" {
+  friendly_name = "terraform incident bot"
+  type          = "webhook"
+
+  webhook {
+    url          = "https://example.com/uptimerobot"
+    content_mode = "json"
+    payload      = jsonencode({ monitor = "*monitorFriendlyName*", details = "*alertDetails*" })
+  }
+}
+`
@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/exileed/uptimerobotapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var mWindowType = map[string]int{
+	"once":    1,
+	"daily":   2,
+	"weekly":  3,
+	"monthly": 4,
+}
+
+var mWindowStatus = map[string]int{
+	"paused": 0,
+	"active": 1,
+}
+
+// mWindowTimeOfDay matches the HH:mm start time of recurring windows.
+var mWindowTimeOfDay = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+func resourceMaintenanceWindow() *schema.Resource {
+	return &schema.Resource{
+		Description: "Uptimerobot maintenance window resource",
+
+		CreateContext: resourceMaintenanceWindowCreate,
+		ReadContext:   resourceMaintenanceWindowRead,
+		UpdateContext: resourceMaintenanceWindowUpdate,
+		DeleteContext: resourceMaintenanceWindowDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceMaintenanceWindowCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"friendly_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The friendly name of the maintenance window.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(mapKeys(mWindowType), false),
+				Description:  "How often the maintenance window recurs: `once`, `daily`, `weekly` or `monthly`.",
+			},
+			"start_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentTimestamps,
+				Description:      "When the maintenance window starts: an RFC 3339 timestamp for `once` windows, the time of day as `HH:mm` for the others.",
+			},
+			"duration": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The duration of the maintenance window in minutes.",
+			},
+			"value": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The days the maintenance window recurs on: days of the week (1 = Monday to 7 = Sunday) for `weekly` windows, days of the month (1 to 31) for `monthly` windows.",
+			},
+			"paused": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the maintenance window is paused.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMaintenanceWindowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+
+	request, err := buildMaintenanceWindowParams(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request.Type = mWindowType[d.Get("type").(string)]
+
+	var mWindow *mWindowSingleResp
+
+	err = retryTime(ctx, func() error {
+		mWindow, err = newMWindow(ctx, client, request)
+		return err
+	}, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	d.SetId(strconv.Itoa(mWindow.MWindow.Id))
+
+	err = pauseAfterCreate(ctx, d, func() error {
+		request.Type = 0
+		request.Id = mWindow.MWindow.Id
+		request.Status = mWindowStatusParam(true)
+
+		_, err := editMWindow(ctx, client, request)
+		return err
+	})
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	return resourceMaintenanceWindowRead(ctx, d, meta)
+}
+
+func resourceMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+	id := d.Id()
+
+	request := getMWindowsParams{
+		MWindows: &id,
+	}
+
+	var m *mWindowsResp
+	var err error
+
+	err = retryTime(ctx, func() error {
+		m, err = getMWindows(ctx, client, request)
+		return err
+	}, d.Timeout(schema.TimeoutRead))
+
+	if isNotFoundError(err) || (err == nil && len(m.MWindows) == 0) {
+		log.Printf("[WARN] MaintenanceWindow %s not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	if err := fillMaintenanceWindow(d, m.MWindows[0]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceMaintenanceWindowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+
+	id := d.Id()
+
+	idInt, err := strconv.Atoi(id)
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	request, err := buildMaintenanceWindowParams(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request.Id = idInt
+
+	if d.HasChange("paused") {
+		request.Status = mWindowStatusParam(d.Get("paused").(bool))
+	}
+
+	err = retryTime(ctx, func() error {
+		_, err = editMWindow(ctx, client, request)
+		return err
+	}, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	return resourceMaintenanceWindowRead(ctx, d, meta)
+}
+
+func resourceMaintenanceWindowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+
+	id := d.Id()
+
+	idInt, err := strconv.Atoi(id)
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	err = retryTime(ctx, func() error {
+		_, err = client.MWindow.DeleteMWindow(idInt)
+		return err
+	}, d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	return nil
+}
+
+// resourceMaintenanceWindowCustomizeDiff checks start_time and value against
+// the window type at plan time.
+func resourceMaintenanceWindowCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	wType := d.Get("type").(string)
+
+	if d.NewValueKnown("start_time") {
+		startTime := d.Get("start_time").(string)
+
+		if wType == "once" {
+			if _, err := time.Parse(time.RFC3339, startTime); err != nil {
+				return fmt.Errorf("start_time: once windows require an RFC 3339 timestamp such as 2021-12-24T22:00:00Z, got %q", startTime)
+			}
+		} else if !mWindowTimeOfDay.MatchString(startTime) {
+			return fmt.Errorf("start_time: %s windows require a time of day as HH:mm, got %q", wType, startTime)
+		}
+	}
+
+	if !d.NewValueKnown("value") {
+		return nil
+	}
+
+	days := d.Get("value").(*schema.Set).List()
+
+	switch wType {
+	case "once", "daily":
+		if len(days) > 0 {
+			return fmt.Errorf("value: not used by %s windows", wType)
+		}
+	case "weekly", "monthly":
+		if len(days) == 0 {
+			return fmt.Errorf("value: required for %s windows", wType)
+		}
+
+		last := 7
+		if wType == "monthly" {
+			last = 31
+		}
+
+		for _, v := range days {
+			if day := v.(int); day < 1 || day > last {
+				return fmt.Errorf("value: %s windows take days between 1 and %d, got %d", wType, last, day)
+			}
+		}
+	}
+
+	return nil
+}
+
+// buildMaintenanceWindowParams collects the parameters shared by newMWindow
+// and editMWindow from the resource configuration.
+func buildMaintenanceWindowParams(d *schema.ResourceData) (mWindowParams, error) {
+	startTime := d.Get("start_time").(string)
+
+	if d.Get("type").(string) == "once" {
+		t, err := time.Parse(time.RFC3339, startTime)
+		if err != nil {
+			return mWindowParams{}, fmt.Errorf("error parsing start_time: %s", err)
+		}
+		startTime = strconv.FormatInt(t.Unix(), 10)
+	}
+
+	return mWindowParams{
+		FriendlyName: d.Get("friendly_name").(string),
+		Value:        joinInts(d.Get("value").(*schema.Set).List(), "-"),
+		StartTime:    startTime,
+		Duration:     d.Get("duration").(int),
+	}, nil
+}
+
+// mWindowStatusParam returns the editMWindow status that pauses or resumes a
+// maintenance window.
+func mWindowStatusParam(paused bool) *int {
+	status := mWindowStatus["active"]
+	if paused {
+		status = mWindowStatus["paused"]
+	}
+	return &status
+}
+
+// suppressEquivalentTimestamps ignores differences between two RFC 3339
+// timestamps of the same instant, e.g. in different time zones.
+func suppressEquivalentTimestamps(_, old, new string, _ *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
+
+func fillMaintenanceWindow(d *schema.ResourceData, m mWindow) error {
+	wType := intToString(mWindowType, m.Type)
+	startTime := string(m.StartTime)
+
+	if wType == "once" {
+		unix, err := strconv.ParseInt(startTime, 10, 64)
+		if err != nil {
+			return fmt.Errorf("error reading start_time for resource %s: %s", d.Id(), err)
+		}
+		startTime = time.Unix(unix, 0).UTC().Format(time.RFC3339)
+	}
+
+	var days []int
+	for _, v := range strings.Split(string(m.Value), "-") {
+		if v == "" {
+			continue
+		}
+
+		day, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("error reading value for resource %s: %s", d.Id(), err)
+		}
+		days = append(days, day)
+	}
+
+	d.Set("friendly_name", m.FriendlyName)
+	d.Set("type", wType)
+	d.Set("start_time", startTime)
+	d.Set("duration", m.Duration)
+	d.Set("paused", m.Status == mWindowStatus["paused"])
+	d.Set("status", intToString(mWindowStatus, m.Status))
+
+	if err := d.Set("value", days); err != nil {
+		return fmt.Errorf("error setting value for resource %s: %s", d.Id(), err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUptimeRobotResourceMaintenanceWindow(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMaintenanceWindow,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "friendly_name", "terraform weekly deploy"),
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "type", "weekly"),
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "start_time", "22:30"),
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "duration", "60"),
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "value.#", "2"),
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "paused", "false"),
+				),
+			},
+			{
+				ResourceName:      "uptimerobot_maintenance_window.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceMaintenanceWindowCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		err    string
+	}{
+		{
+			name:   "valid once",
+			config: map[string]interface{}{"type": "once", "start_time": "2021-12-24T22:00:00+01:00"},
+		},
+		{
+			name:   "valid monthly",
+			config: map[string]interface{}{"type": "monthly", "start_time": "03:00", "value": []interface{}{1, 15, 31}},
+		},
+		{
+			name:   "once with time of day",
+			config: map[string]interface{}{"type": "once", "start_time": "22:00"},
+			err:    "start_time: once windows require an RFC 3339 timestamp",
+		},
+		{
+			name:   "daily with timestamp",
+			config: map[string]interface{}{"type": "daily", "start_time": "2021-12-24T22:00:00Z"},
+			err:    "start_time: daily windows require a time of day as HH:mm",
+		},
+		{
+			name:   "daily with days",
+			config: map[string]interface{}{"type": "daily", "start_time": "22:00", "value": []interface{}{1}},
+			err:    "value: not used by daily windows",
+		},
+		{
+			name:   "weekly without days",
+			config: map[string]interface{}{"type": "weekly", "start_time": "22:00"},
+			err:    "value: required for weekly windows",
+		},
+		{
+			name:   "weekly on day 8",
+			config: map[string]interface{}{"type": "weekly", "start_time": "22:00", "value": []interface{}{8}},
+			err:    "value: weekly windows take days between 1 and 7, got 8",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]interface{}{"friendly_name": "test", "duration": 30}
+			for k, v := range tc.config {
+				config[k] = v
+			}
+
+			_, err := resourceMaintenanceWindow().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+const testAccResourceMaintenanceWindow = `
+resource "uptimerobot_maintenance_window" "test" {
+  friendly_name = "terraform weekly deploy"
+  type          = "weekly"
+  start_time    = "22:30"
+  duration      = 60
+  value         = [2, 4]
+}
+`
@@ -2,21 +2,28 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"log"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/exileed/uptimerobotapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const heartbeatBaseURL = "https://heartbeat.uptimerobot.com/"
+
 var monitorType = map[string]int{
-	"http":    1,
-	"keyword": 2,
-	"ping":    3,
-	"port":    4,
+	"http":      1,
+	"keyword":   2,
+	"ping":      3,
+	"port":      4,
+	"heartbeat": 5,
 }
 var monitorSubType = map[string]int{
 	"http":   1,
@@ -33,6 +40,12 @@ var monitorHTTPAuthType = map[string]int{
 	"digest": 2,
 }
 
+// monitorHTTPMethodDefault is the method of monitors without http_method.
+var monitorHTTPMethodDefault = map[string]string{
+	"http":    "head",
+	"keyword": "get",
+}
+
 var monitorHTTPMethodType = map[string]int{
 	"head":    1,
 	"get":     2,
@@ -43,6 +56,24 @@ var monitorHTTPMethodType = map[string]int{
 	"options": 7,
 }
 
+var monitorKeywordType = map[string]int{
+	"exists":     1,
+	"not_exists": 2,
+}
+
+var monitorPostType = map[string]int{
+	"key_value": 1,
+	"raw_json":  2,
+}
+
+var monitorPostContentType = map[string]int{
+	"text/html":        0,
+	"application/json": 1,
+}
+
+// monitorHTTPMethodsWithBody lists the HTTP methods that may send post_value.
+var monitorHTTPMethodsWithBody = []string{"post", "put", "patch", "delete"}
+
 var monitorStatusType = map[string]int{
 	"paused":          0,
 	"not_checked_yet": 1,
@@ -59,30 +90,242 @@ func resourceMonitor() *schema.Resource {
 		ReadContext:   resourceMonitorRead,
 		UpdateContext: resourceMonitorUpdate,
 		DeleteContext: resourceMonitorDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceMonitorImport,
+		},
+		CustomizeDiff: resourceMonitorCustomizeDiff,
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceMonitorV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceMonitorStateUpgradeV0,
+			},
+		},
+
+		Schema: resourceMonitorSchema(),
+	}
+}
+
+func resourceMonitorSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"friendly_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The friendly name of the monitor.",
+		},
+		"url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The URL, IP or host to monitor. Required for all monitor types except `heartbeat`.",
+		},
+		"heartbeat_url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The URL a `heartbeat` monitor expects to be requested at least once per `interval`.",
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(mapKeys(monitorType), false),
+		},
+		"sub_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(mapKeys(monitorSubType), false),
+		},
+		"port": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"interval": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  300,
+		},
+		"timeout": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  30,
+		},
+		"keyword_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(mapKeys(monitorKeywordType), false),
+			Description:  "Whether the keyword monitor alerts when the keyword `exists` or `not_exists` on the page. Only valid for `keyword` monitors.",
+		},
+		"keyword_case_sensitive": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the keyword is matched case-sensitively. Only valid for `keyword` monitors.",
+		},
+		"keyword_value": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The keyword to look for on the page. Only valid for `keyword` monitors.",
+		},
+		"http_username": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"http_password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "The HTTP authentication password. The API does not return it for most accounts, so the configured value is kept in state.",
+		},
+		"http_auth_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(mapKeys(monitorHTTPAuthType), false),
+		},
+		"http_method": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(mapKeys(monitorHTTPMethodType), false),
+			Description:  "The HTTP method used by `http` and `keyword` monitors. Defaults to `head` for `http` and `get` for `keyword` monitors.",
+		},
+		"post_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(mapKeys(monitorPostType), false),
+			Description:  "How `post_value` is sent: as `key_value` pairs or as `raw_json`.",
+		},
+		"post_value": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: structure.SuppressJsonDiff,
+			Description:      "The request body as a JSON document. Only allowed for the `post`, `put`, `patch` and `delete` methods.",
+		},
+		"post_content_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(mapKeys(monitorPostContentType), false),
+			Description:  "The `Content-Type` header sent with `post_value`. Defaults to `text/html`.",
+		},
+		"custom_http_headers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Sensitive:   true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Custom HTTP headers sent by `http` and `keyword` monitors, keyed by header name.",
+		},
+		"custom_http_statuses": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "HTTP status codes that override the default up/down classification of `http` and `keyword` monitors.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"up": {
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(100, 599)},
+						Description: "Status codes treated as up.",
+					},
+					"down": {
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(100, 599)},
+						Description: "Status codes treated as down.",
+					},
+				},
+			},
+		},
+		"paused": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the monitor is paused. Paused monitors are not checked and send no alerts.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The observed status of the monitor: `paused`, `not_checked_yet`, `up`, `seems_down` or `down`.",
+		},
+		"ignore_ssl_errors": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"maintenance_window_ids": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeInt},
+			Description: "The IDs of the maintenance windows during which the monitor sends no alerts.",
+		},
+		"ignore_alert_contacts": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"alert_contact"},
+			Description:   "Leave the alert contacts of the monitor alone, for monitors whose contacts are attached with `uptimerobot_monitor_alert_contact`.",
+		},
+		"alert_contact": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"threshold": {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Default:  0,
+					},
+					"recurrence": {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Default:  0,
+					},
+				},
+			},
 		},
+	}
+}
 
+// resourceMonitorV0 is the monitor schema before alert_contact became a set.
+// It is a frozen copy that only describes the shape of version 0 states, so
+// it must not change when attributes are added to the current schema.
+func resourceMonitorV0() *schema.Resource {
+	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"friendly_name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The friendly name of the monitor.",
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"url": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+			},
+			"heartbeat_url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"type": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice(mapKeys(monitorType), false),
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
 			},
 			"sub_type": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice(mapKeys(monitorSubType), false),
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"port": {
 				Type:     schema.TypeInt,
@@ -98,6 +341,19 @@ func resourceMonitor() *schema.Resource {
 				Optional: true,
 				Default:  30,
 			},
+			"keyword_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"keyword_case_sensitive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"keyword_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"http_username": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -108,15 +364,56 @@ func resourceMonitor() *schema.Resource {
 				Sensitive: true,
 			},
 			"http_auth_type": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice(mapKeys(monitorHTTPAuthType), false),
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"http_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"post_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"post_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"post_content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"custom_http_headers": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"custom_http_statuses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"up": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+						"down": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+			"paused": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
-			//"http_method": {
-			//	Type:         schema.TypeString,
-			//	Optional:     true,
-			//	ValidateFunc: validation.StringInSlice(mapKeys(monitorHTTPMethodType), false),
-			//},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -152,74 +449,54 @@ func resourceMonitor() *schema.Resource {
 	}
 }
 
-func resourceMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(uptimerobotapi.Client)
-
-	mType := d.Get("type").(string)
-	mInterval := d.Get("interval").(int)
-	mTimeout := d.Get("timeout").(int)
-
-	request := uptimerobotapi.NewMonitorsParams{
-		FriendlyName: d.Get("friendly_name").(string),
-		Url:          d.Get("url").(string),
-		Type:         monitorType[mType],
-		////KeywordType: &monitorSubType[mSubType], //@todo
-		////KeywordCaseType: &monitorSubType[mSubType],
-		////KeywordValue: &monitorSubType[mSubType],
-		Interval: &mInterval,
-		Timeout:  &mTimeout,
+// resourceMonitorStateUpgradeV0 migrates the alert_contact list to a set.
+// Both are stored as JSON arrays, so only contacts listed more than once need
+// to be dropped to keep the set valid.
+func resourceMonitorStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	contacts, ok := rawState["alert_contact"].([]interface{})
+	if !ok {
+		return rawState, nil
 	}
 
-	mPort := d.Get("port")
-	if mPort != nil {
-		mPortInt := mPort.(int)
-		request.Port = &mPortInt
-	}
+	seen := make(map[string]bool, len(contacts))
+	upgraded := make([]interface{}, 0, len(contacts))
 
-	mSubType := d.Get("sub_type")
-	if mSubType != nil {
-		mSubTypeInt := monitorSubType[mSubType.(string)]
-		request.SubType = &mSubTypeInt
-	}
+	for _, v := range contacts {
+		contact, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
 
-	mHttpUsername := d.Get("http_username")
-	if mHttpUsername != nil {
-		mHttpUsernameString := mHttpUsername.(string)
-		request.HttpUsername = &mHttpUsernameString
-	}
+		id := fmt.Sprintf("%v", contact["id"])
+		if seen[id] {
+			log.Printf("[WARN] Dropping duplicate alert_contact %s from monitor %v", id, rawState["id"])
+			continue
+		}
 
-	mHttpPassword := d.Get("http_password")
-	if mHttpPassword != nil {
-		mHttpPasswordString := mHttpPassword.(string)
-		request.HttpPassword = &mHttpPasswordString
+		seen[id] = true
+		upgraded = append(upgraded, contact)
 	}
 
-	mHttpAuthType := d.Get("http_auth_type")
-	if mHttpAuthType != nil {
-		mHttpAuthTypeInt := monitorHTTPAuthType[mHttpAuthType.(string)]
-		request.HttpAuthType = &mHttpAuthTypeInt
-	}
+	rawState["alert_contact"] = upgraded
 
-	alertContactMap := d.Get("alert_contact").([]interface{})
-	acStrings := make([]string, len(alertContactMap))
+	return rawState, nil
+}
 
-	for k, v := range alertContactMap {
-		id := v.(map[string]interface{})["id"].(string)
-		threshold := v.(map[string]interface{})["threshold"].(int)
-		recurrence := v.(map[string]interface{})["recurrence"].(int)
+func resourceMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
 
-		acStrings[k] = fmt.Sprintf("%s_%d_%d", id, threshold, recurrence)
+	request, err := buildMonitorParams(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	alertContactStr := strings.Join(acStrings, "-")
-	request.AlertContacts = &alertContactStr
+	request.Type = monitorType[d.Get("type").(string)]
 
 	var monitor *uptimerobotapi.MonitorsSingResp
-	var err error
 
-	err = retryTime(func() error {
-		monitor, err = client.Monitor.NewMonitor(request)
+	err = retryTime(ctx, func() error {
+		monitor, err = newMonitor(ctx, client, request)
 		return err
-	}, timeoutMinutes)
+	}, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return diag.Errorf(err.Error())
@@ -227,39 +504,59 @@ func resourceMonitorCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(strconv.Itoa(monitor.Monitor.Id))
 
-	return nil
+	err = pauseAfterCreate(ctx, d, func() error {
+		request.Type = 0
+		request.Id = monitor.Monitor.Id
+		request.Status = monitorStatusParam(true)
+
+		_, err := editMonitor(ctx, client, request)
+		return err
+	})
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	return resourceMonitorRead(ctx, d, meta)
 }
 
 func resourceMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(uptimerobotapi.Client)
 	id := d.Id()
 
-	request := uptimerobotapi.GetMonitorsParams{
-		Monitors:      &id,
-		AlertContacts: 1,
-		SSL:           1,
+	request := getMonitorsParams{
+		Monitors:           &id,
+		AlertContacts:      1,
+		MWindows:           1,
+		SSL:                1,
+		CustomHttpHeaders:  1,
+		CustomHttpStatuses: 1,
 	}
 
-	var m *uptimerobotapi.MonitorsResp
+	var m *monitorsResp
 	var err error
 
-	err = retryTime(func() error {
-		m, err = client.Monitor.GetMonitors(request)
+	err = retryTime(ctx, func() error {
+		m, err = getMonitors(ctx, client, request)
 		return err
-	}, timeoutMinutes)
+	}, d.Timeout(schema.TimeoutRead))
 
-	if err != nil {
-		return diag.Errorf(err.Error())
+	if isNotFoundError(err) || (err == nil && len(m.Monitors) == 0) {
+		log.Printf("[WARN] Monitor %s not found, removing from state", id)
+		d.SetId("")
+		return nil
 	}
 
-	if m.Pagination.Total == 0 {
-		return diag.Errorf("Monitor %d not found", m.Monitors[0].Id)
+	if err != nil {
+		return diag.Errorf(err.Error())
 	}
 
 	monitor := m.Monitors[0]
 
 	d.Set("id", monitor.Id)
-	fillMonitor(d, monitor)
+	if err := fillMonitor(d, monitor); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -275,114 +572,611 @@ func resourceMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.Errorf(err.Error())
 	}
 
+	request, err := buildMonitorParams(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request.Id = idInt
+
+	if d.HasChange("paused") {
+		request.Status = monitorStatusParam(d.Get("paused").(bool))
+	}
+
+	err = retryTime(ctx, func() error {
+		_, err = editMonitor(ctx, client, request)
+		return err
+	}, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	return resourceMonitorRead(ctx, d, meta)
+}
+
+func resourceMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+
+	id := d.Id()
+
+	idInt, err := strconv.Atoi(id)
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	err = retryTime(ctx, func() error {
+		_, err = client.Monitor.DeleteMonitor(idInt)
+		return err
+	}, d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	return nil
+}
+
+// resourceMonitorImport accepts a numeric monitor ID or one of
+// "url=<url>", "name=<friendly name>" and "<type>:<url>", which are resolved
+// to the ID of the single monitor they match.
+func resourceMonitorImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+
+	if _, err := strconv.Atoi(importID); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	var search string
+	var match func(monitor) bool
+
+	switch {
+	case strings.HasPrefix(importID, "url="):
+		search = strings.TrimPrefix(importID, "url=")
+		match = func(m monitor) bool { return m.Url == search }
+	case strings.HasPrefix(importID, "name="):
+		search = strings.TrimPrefix(importID, "name=")
+		match = func(m monitor) bool { return m.FriendlyName == search }
+	default:
+		parts := strings.SplitN(importID, ":", 2)
+		mType, ok := monitorType[parts[0]]
+		if len(parts) != 2 || !ok {
+			return nil, fmt.Errorf("unexpected import ID %q, expected a monitor ID, url=<url>, name=<friendly name> or <type>:<url>", importID)
+		}
+		search = parts[1]
+		match = func(m monitor) bool { return m.Type == mType && m.Url == search }
+	}
+
+	if search == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, the value to look up is empty", importID)
+	}
+
+	client := meta.(uptimerobotapi.Client)
+
+	var monitors []monitor
+	var err error
+
+	err = retryTime(ctx, func() error {
+		monitors, err = getAllMonitors(ctx, client, getMonitorsParams{Search: &search})
+		return err
+	}, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []monitor
+	for _, m := range monitors {
+		if match(m) {
+			matches = append(matches, m)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no monitor matches import ID %q", importID)
+	case 1:
+		d.SetId(strconv.Itoa(matches[0].Id))
+		return []*schema.ResourceData{d}, nil
+	}
+
+	candidates := make([]string, len(matches))
+	for i, m := range matches {
+		candidates[i] = fmt.Sprintf("%d (%s, %s %s)", m.Id, m.FriendlyName, intToString(monitorType, m.Type), m.Url)
+	}
+
+	return nil, fmt.Errorf("import ID %q matches %d monitors, import one of them by ID instead: %s", importID, len(matches), strings.Join(candidates, "; "))
+}
+
+// resourceMonitorCustomizeDiff enforces the per-type rules of the API at plan
+// time. Values that are unknown until apply are left to the API to check.
+func resourceMonitorCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	mType := d.Get("type").(string)
+
+	validators := []func(*schema.ResourceDiff, string) error{
+		validateMonitorURL,
+		validateMonitorPort,
+		validateMonitorHTTP,
+		validateMonitorHTTPAuth,
+		validateMonitorKeyword,
+		validateMonitorTimeout,
+		validateMonitorAlertContacts,
+	}
+
+	for _, validate := range validators {
+		if err := validate(d, mType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateMonitorURL(d *schema.ResourceDiff, mType string) error {
+	if !d.NewValueKnown("url") {
+		return nil
+	}
+
+	url := d.Get("url").(string)
+	hasScheme := strings.Contains(url, "://")
+
+	switch mType {
+	case "heartbeat":
+		if url != "" {
+			return fmt.Errorf("url: not used by heartbeat monitors, the ping URL is exported as heartbeat_url")
+		}
+	case "http", "keyword":
+		if url == "" {
+			return fmt.Errorf("url: required for %s monitors", mType)
+		}
+		if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+			return fmt.Errorf("url: %s monitors require an http:// or https:// URL, got %q", mType, url)
+		}
+	case "ping", "port":
+		if url == "" {
+			return fmt.Errorf("url: required for %s monitors", mType)
+		}
+		if hasScheme {
+			return fmt.Errorf("url: %s monitors require a host name or IP address without a scheme, got %q", mType, url)
+		}
+	}
+
+	return nil
+}
+
+func validateMonitorPort(d *schema.ResourceDiff, mType string) error {
+	subType, hasSubType := d.GetOk("sub_type")
+	_, hasPort := d.GetOk("port")
+
+	if mType != "port" {
+		if hasSubType {
+			return fmt.Errorf("sub_type: only valid for port monitors, got type %q", mType)
+		}
+		if hasPort {
+			return fmt.Errorf("port: only valid for port monitors, got type %q", mType)
+		}
+		return nil
+	}
+
+	if !d.NewValueKnown("sub_type") || !d.NewValueKnown("port") {
+		return nil
+	}
+
+	if !hasSubType {
+		return fmt.Errorf("sub_type: required for port monitors")
+	}
+	if subType.(string) == "custom" && !hasPort {
+		return fmt.Errorf("port: required when sub_type is \"custom\"")
+	}
+	if subType.(string) != "custom" && hasPort {
+		return fmt.Errorf("port: only valid when sub_type is \"custom\", %q uses its standard port", subType.(string))
+	}
+
+	return nil
+}
+
+func validateMonitorHTTP(d *schema.ResourceDiff, mType string) error {
+	// http_method is Computed, so a method that is no longer configured is
+	// still in state. It is reset to the default of the type then, and only a
+	// method set in the configuration is checked against a new type.
+	configured, ok := configuredAttr(d.GetRawConfig(), "http_method")
+	if ok && !configured {
+		if method, hasDefault := monitorHTTPMethodDefault[mType]; hasDefault && d.Get("http_method").(string) != method {
+			if err := d.SetNew("http_method", method); err != nil {
+				return err
+			}
+		} else if !hasDefault && d.HasChange("type") {
+			if err := d.SetNewComputed("http_method"); err != nil {
+				return err
+			}
+		}
+	}
+
+	// post_content_type is Computed as well and stays in state after
+	// post_value is removed, so only a configured one needs a body.
+	bodyKeys := []string{"post_type", "post_value"}
+	if configured, ok := configuredAttr(d.GetRawConfig(), "post_content_type"); !ok || configured {
+		bodyKeys = append(bodyKeys, "post_content_type")
+	}
+
+	if mType != "http" && mType != "keyword" {
+		for _, key := range append([]string{"http_method"}, bodyKeys...) {
+			if v, ok := d.GetOk(key); ok && v.(string) != "" {
+				return fmt.Errorf("%s: only valid for http and keyword monitors, got type %q", key, mType)
+			}
+		}
+		if v, ok := d.GetOk("custom_http_headers"); ok && len(v.(map[string]interface{})) > 0 {
+			return fmt.Errorf("custom_http_headers: only valid for http and keyword monitors, got type %q", mType)
+		}
+		if v, ok := d.GetOk("custom_http_statuses"); ok && len(v.([]interface{})) > 0 {
+			return fmt.Errorf("custom_http_statuses: only valid for http and keyword monitors, got type %q", mType)
+		}
+		return nil
+	}
+
+	if d.NewValueKnown("http_method") {
+		method := d.Get("http_method").(string)
+		for _, key := range bodyKeys {
+			if v, ok := d.GetOk(key); ok && v.(string) != "" && !stringInSlice(monitorHTTPMethodsWithBody, method) {
+				return fmt.Errorf("%s: a request body requires http_method to be one of %s, got %q", key, strings.Join(monitorHTTPMethodsWithBody, ", "), method)
+			}
+		}
+	}
+	if _, ok := d.GetOk("post_value"); ok && d.Get("post_type").(string) == "" {
+		return fmt.Errorf("post_type: required when post_value is set")
+	}
+
+	if up, down := expandCustomHTTPStatuses(d.Get("custom_http_statuses").([]interface{})); len(up) > 0 && len(down) > 0 {
+		for _, code := range up {
+			if intInSlice(down, code) {
+				return fmt.Errorf("custom_http_statuses.0: status code %d is listed as both up and down", code)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateMonitorHTTPAuth(d *schema.ResourceDiff, mType string) error {
+	keys := []string{"http_username", "http_password", "http_auth_type"}
+
+	var set, unset []string
+	for _, key := range keys {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+		if _, ok := d.GetOk(key); ok {
+			set = append(set, key)
+		} else {
+			unset = append(unset, key)
+		}
+	}
+
+	if len(set) == 0 {
+		return nil
+	}
+	if mType != "http" && mType != "keyword" {
+		return fmt.Errorf("%s: only valid for http and keyword monitors, got type %q", set[0], mType)
+	}
+	if len(unset) > 0 {
+		return fmt.Errorf("%s: required when %s is set, HTTP authentication needs %s together", unset[0], set[0], strings.Join(keys, ", "))
+	}
+
+	return nil
+}
+
+func validateMonitorKeyword(d *schema.ResourceDiff, mType string) error {
+	if mType != "keyword" {
+		for _, key := range []string{"keyword_type", "keyword_value"} {
+			if v, ok := d.GetOk(key); ok && v.(string) != "" {
+				return fmt.Errorf("%s: only valid for keyword monitors, got type %q", key, mType)
+			}
+		}
+		if d.Get("keyword_case_sensitive").(bool) {
+			return fmt.Errorf("keyword_case_sensitive: only valid for keyword monitors, got type %q", mType)
+		}
+		return nil
+	}
+
+	if d.NewValueKnown("keyword_type") && d.Get("keyword_type").(string) == "" {
+		return fmt.Errorf("keyword_type: required for keyword monitors")
+	}
+	if d.NewValueKnown("keyword_value") && d.Get("keyword_value").(string) == "" {
+		return fmt.Errorf("keyword_value: required for keyword monitors")
+	}
+
+	return nil
+}
+
+func validateMonitorTimeout(d *schema.ResourceDiff, mType string) error {
+	if mType == "heartbeat" || !d.NewValueKnown("timeout") || !d.NewValueKnown("interval") {
+		return nil
+	}
+
+	timeout := d.Get("timeout").(int)
+	interval := d.Get("interval").(int)
+	if timeout >= interval {
+		return fmt.Errorf("timeout: must be lower than interval (%d seconds), got %d", interval, timeout)
+	}
+
+	return nil
+}
+
+func validateMonitorAlertContacts(d *schema.ResourceDiff, _ string) error {
+	seen := map[string]bool{}
+
+	for _, v := range d.Get("alert_contact").(*schema.Set).List() {
+		id := v.(map[string]interface{})["id"].(string)
+		if id == "" {
+			continue
+		}
+		if seen[id] {
+			return fmt.Errorf("alert_contact: contact %s is listed more than once", id)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// buildMonitorParams collects the parameters shared by newMonitor and
+// editMonitor from the resource configuration.
+func buildMonitorParams(d *schema.ResourceData) (monitorParams, error) {
 	mInterval := d.Get("interval").(int)
 	mTimeout := d.Get("timeout").(int)
+	mIgnoreSSLErrors := d.Get("ignore_ssl_errors").(bool)
 
-	request := uptimerobotapi.EditMonitorsParams{
-		FriendlyName: d.Get("friendly_name").(string),
-		Url:          d.Get("url").(string),
-		Interval:     &mInterval,
-		Timeout:      &mTimeout,
+	request := monitorParams{
+		FriendlyName:    d.Get("friendly_name").(string),
+		Url:             d.Get("url").(string),
+		Interval:        &mInterval,
+		Timeout:         &mTimeout,
+		IgnoreSSLErrors: &mIgnoreSSLErrors,
 	}
 
-	mPort := d.Get("port")
-	if mPort != nil {
+	if mPort, ok := d.GetOk("port"); ok {
 		mPortInt := mPort.(int)
 		request.Port = &mPortInt
 	}
 
-	mSubType := d.Get("sub_type")
-	if mSubType != nil {
+	if mSubType, ok := d.GetOk("sub_type"); ok {
 		mSubTypeInt := monitorSubType[mSubType.(string)]
 		request.SubType = &mSubTypeInt
 	}
 
-	mHttpUsername := d.Get("http_username")
-	if mHttpUsername != nil {
+	if d.Get("type").(string) == "keyword" {
+		mKeywordTypeInt := monitorKeywordType[d.Get("keyword_type").(string)]
+		request.KeywordType = &mKeywordTypeInt
+
+		// The API counts 0 as case-sensitive and 1 as case-insensitive.
+		mKeywordCaseType := 1
+		if d.Get("keyword_case_sensitive").(bool) {
+			mKeywordCaseType = 0
+		}
+		request.KeywordCaseType = &mKeywordCaseType
+
+		mKeywordValue := d.Get("keyword_value").(string)
+		request.KeywordValue = &mKeywordValue
+	}
+
+	if mWindows, ok := d.GetOk("maintenance_window_ids"); ok || d.HasChange("maintenance_window_ids") {
+		mWindowsString := joinInts(mWindows.(*schema.Set).List(), "-")
+		request.MWindows = &mWindowsString
+	}
+
+	if mHttpMethod, ok := d.GetOk("http_method"); ok {
+		mHttpMethodInt := monitorHTTPMethodType[mHttpMethod.(string)]
+		request.HttpMethod = &mHttpMethodInt
+	}
+
+	if mPostType, ok := d.GetOk("post_type"); ok {
+		mPostTypeInt := monitorPostType[mPostType.(string)]
+		request.PostType = &mPostTypeInt
+	}
+
+	// An empty post_value is sent on purpose when it is removed, otherwise
+	// the API keeps the previous body.
+	if mPostValue, ok := d.GetOk("post_value"); ok || d.HasChange("post_value") {
+		mPostValueString, _ := mPostValue.(string)
+		request.PostValue = &mPostValueString
+	}
+
+	if mPostContentType, ok := d.GetOk("post_content_type"); ok {
+		mPostContentTypeInt := monitorPostContentType[mPostContentType.(string)]
+		request.PostContentType = &mPostContentTypeInt
+	}
+
+	// Removed headers are cleared by sending an empty object.
+	if mHeaders, ok := d.GetOk("custom_http_headers"); ok || d.HasChange("custom_http_headers") {
+		headers := map[string]string{}
+		for k, v := range mHeaders.(map[string]interface{}) {
+			headers[k] = v.(string)
+		}
+
+		headersJSON, err := json.Marshal(headers)
+		if err != nil {
+			return request, fmt.Errorf("error encoding custom_http_headers: %s", err)
+		}
+
+		mHeadersString := string(headersJSON)
+		request.CustomHttpHeaders = &mHeadersString
+	}
+
+	if mStatuses, ok := d.GetOk("custom_http_statuses"); ok || d.HasChange("custom_http_statuses") {
+		mStatusesString := encodeCustomHTTPStatuses(expandCustomHTTPStatuses(mStatuses.([]interface{})))
+		request.CustomHttpStatuses = &mStatusesString
+	}
+
+	// Credentials and their auth type are sent when set or changed, so
+	// removing them from the configuration sends an empty value that clears
+	// them.
+	if mHttpUsername, ok := d.GetOk("http_username"); ok || d.HasChange("http_username") {
 		mHttpUsernameString := mHttpUsername.(string)
 		request.HttpUsername = &mHttpUsernameString
 	}
 
-	mHttpPassword := d.Get("http_password")
-	if mHttpPassword != nil {
+	if mHttpPassword, ok := d.GetOk("http_password"); ok || d.HasChange("http_password") {
 		mHttpPasswordString := mHttpPassword.(string)
 		request.HttpPassword = &mHttpPasswordString
 	}
 
-	mHttpAuthType := d.Get("http_auth_type")
-	if mHttpAuthType != nil {
+	if mHttpAuthType, ok := d.GetOk("http_auth_type"); ok || d.HasChange("http_auth_type") {
 		mHttpAuthTypeInt := monitorHTTPAuthType[mHttpAuthType.(string)]
 		request.HttpAuthType = &mHttpAuthTypeInt
 	}
 
-	alertContactMap := d.Get("alert_contact").([]interface{})
-	acStrings := make([]string, len(alertContactMap))
+	// Contacts attached with uptimerobot_monitor_alert_contact are left as
+	// they are by not sending alert_contacts at all.
+	if !d.Get("ignore_alert_contacts").(bool) {
+		alertContactMap := d.Get("alert_contact").(*schema.Set).List()
+		contacts := make([]uptimerobotapi.AlertContactMonitor, len(alertContactMap))
 
-	for k, v := range alertContactMap {
+		for k, v := range alertContactMap {
+			contacts[k] = uptimerobotapi.AlertContactMonitor{
+				Id:         v.(map[string]interface{})["id"].(string),
+				Threshold:  v.(map[string]interface{})["threshold"].(int),
+				Recurrence: v.(map[string]interface{})["recurrence"].(int),
+			}
+		}
 
-		id := v.(map[string]interface{})["id"].(string)
-		threshold := v.(map[string]interface{})["threshold"].(int)
-		recurrence := v.(map[string]interface{})["recurrence"].(int)
+		alertContactStr := encodeMonitorAlertContacts(contacts)
+		request.AlertContacts = &alertContactStr
+	}
+
+	return request, nil
+}
 
-		acStrings[k] = fmt.Sprintf("%s_%d_%d", id, threshold, recurrence)
+// encodeMonitorAlertContacts builds the API's alert_contacts list of
+// "id_threshold_recurrence" entries joined by dashes.
+func encodeMonitorAlertContacts(contacts []uptimerobotapi.AlertContactMonitor) string {
+	acStrings := make([]string, len(contacts))
+
+	for k, v := range contacts {
+		acStrings[k] = fmt.Sprintf("%s_%d_%d", v.Id, v.Threshold, v.Recurrence)
 	}
-	alertContactStr := strings.Join(acStrings, "-")
-	request.AlertContacts = &alertContactStr
+	sort.Strings(acStrings)
 
-	err = retryTime(func() error {
-		_, err = client.Monitor.EditMonitor(idInt, request)
-		return err
-	}, timeoutMinutes)
+	return strings.Join(acStrings, "-")
+}
 
-	if err != nil {
-		return diag.Errorf(err.Error())
+// isMaskedSecret reports whether the API withheld a secret, returning it
+// either empty or as asterisks.
+func isMaskedSecret(secret string) bool {
+	return strings.Trim(secret, "*") == ""
+}
+
+// monitorStatusParam returns the editMonitor status that pauses (0) or
+// resumes (1) a monitor.
+func monitorStatusParam(paused bool) *int {
+	status := 1
+	if paused {
+		status = 0
 	}
+	return &status
+}
 
-	return resourceMonitorRead(ctx, d, meta)
+// heartbeatURL returns the ping URL of a heartbeat monitor. Depending on the
+// account the API reports either the full URL or only its token.
+func heartbeatURL(url string) string {
+	if url == "" || strings.HasPrefix(url, "https://") || strings.HasPrefix(url, "http://") {
+		return url
+	}
+
+	return heartbeatBaseURL + url
 }
 
-func resourceMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(uptimerobotapi.Client)
+// expandCustomHTTPStatuses returns the up and down status codes of a
+// custom_http_statuses block.
+func expandCustomHTTPStatuses(raw []interface{}) (up []int, down []int) {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil, nil
+	}
 
-	id := d.Id()
+	block := raw[0].(map[string]interface{})
+	for _, v := range block["up"].(*schema.Set).List() {
+		up = append(up, v.(int))
+	}
+	for _, v := range block["down"].(*schema.Set).List() {
+		down = append(down, v.(int))
+	}
 
-	idInt, err := strconv.Atoi(id)
+	return up, down
+}
 
-	if err != nil {
-		return diag.Errorf(err.Error())
+// encodeCustomHTTPStatuses builds the API's "code:status" list, where status
+// 1 means up and 0 means down, e.g. "404:0_401:1".
+func encodeCustomHTTPStatuses(up []int, down []int) string {
+	statuses := make(map[int]int, len(up)+len(down))
+	for _, code := range up {
+		statuses[code] = 1
+	}
+	for _, code := range down {
+		statuses[code] = 0
 	}
 
-	err = retryTime(func() error {
-		_, err = client.Monitor.DeleteMonitor(idInt)
-		return err
-	}, timeoutMinutes)
+	codes := make([]int, 0, len(statuses))
+	for code := range statuses {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
 
-	if err != nil {
-		return diag.Errorf(err.Error())
+	parts := make([]string, len(codes))
+	for i, code := range codes {
+		parts[i] = fmt.Sprintf("%d:%d", code, statuses[code])
 	}
 
-	return nil
+	return strings.Join(parts, "_")
+}
+
+// decodeCustomHTTPStatuses is the inverse of encodeCustomHTTPStatuses.
+func decodeCustomHTTPStatuses(s string) (up []int, down []int, err error) {
+	if s == "" {
+		return nil, nil, nil
+	}
+
+	for _, part := range strings.Split(s, "_") {
+		pair := strings.SplitN(part, ":", 2)
+		if len(pair) != 2 {
+			return nil, nil, fmt.Errorf("unexpected status %q", part)
+		}
+
+		code, err := strconv.Atoi(pair[0])
+		if err != nil {
+			return nil, nil, fmt.Errorf("unexpected status code %q", pair[0])
+		}
+
+		switch pair[1] {
+		case "1":
+			up = append(up, code)
+		case "0":
+			down = append(down, code)
+		default:
+			return nil, nil, fmt.Errorf("unexpected status %q for code %d", pair[1], code)
+		}
+	}
+
+	return up, down, nil
 }
 
-func fillMonitor(d *schema.ResourceData, m uptimerobotapi.Monitor) {
-	d.Set("friendly_name", m.FriendlyName)
-	d.Set("url", m.Url)
-	d.Set("type", intToString(monitorType, m.Type))
-	d.Set("sub_type", m.SubType)
-	d.Set("keyword_type", m.KeywordType)
-	d.Set("keyword_case_type", m.KeywordCaseType)
-	d.Set("keyword_value", m.KeywordValue)
-	d.Set("http_username", m.HttpUsername)
-	d.Set("http_password", m.HttpPassword)
-	d.Set("port", m.Port)
-	d.Set("interval", m.Interval)
-	d.Set("timeout", m.Timeout)
-	d.Set("ignore_ssl_errors", m.SSL.IgnoreErrors == 1)
-	d.Set("status", intToString(monitorStatusType, m.Status))
-
-	rawContacts := make([]map[string]interface{}, len(*m.AlertContacts))
-
-	for k, v := range *m.AlertContacts {
+func fillMonitor(d *schema.ResourceData, m monitor) error {
+	if err := fillMonitorAttributes(d, m); err != nil {
+		return err
+	}
+
+	// Most accounts get the password back empty or masked; keep the
+	// configured one then, so only a password changed in the UptimeRobot
+	// dashboard shows up as drift.
+	if !isMaskedSecret(m.HttpPassword) {
+		d.Set("http_password", m.HttpPassword)
+	}
+
+	if d.Get("ignore_alert_contacts").(bool) {
+		return nil
+	}
+
+	rawContacts := make([]map[string]interface{}, len(m.AlertContacts))
+
+	for k, v := range m.AlertContacts {
 		rawContacts[k] = map[string]interface{}{
 			"id":         v.Id,
 			"recurrence": v.Recurrence,
@@ -390,6 +1184,98 @@ func fillMonitor(d *schema.ResourceData, m uptimerobotapi.Monitor) {
 		}
 	}
 	if err := d.Set("alert_contact", rawContacts); err != nil {
-		fmt.Errorf("error setting alert_contact for resource %s: %s", d.Id(), err.Error())
+		return fmt.Errorf("error setting alert_contact for resource %s: %s", d.Id(), err.Error())
 	}
+
+	return nil
+}
+
+// fillMonitorAttributes sets the attributes the monitor resource shares with
+// the uptimerobot_monitor data source, so it must only set keys both schemas
+// have.
+func fillMonitorAttributes(d *schema.ResourceData, m monitor) error {
+	mType := intToString(monitorType, m.Type)
+
+	attributes := map[string]interface{}{
+		"friendly_name":          m.FriendlyName,
+		"type":                   mType,
+		"url":                    m.Url,
+		"heartbeat_url":          "",
+		"sub_type":               intToString(monitorSubType, int(m.SubType)),
+		"http_username":          m.HttpUsername,
+		"port":                   0,
+		"interval":               m.Interval,
+		"timeout":                m.Timeout,
+		"status":                 intToString(monitorStatusType, m.Status),
+		"paused":                 m.Status == monitorStatusType["paused"],
+		"http_method":            "",
+		"custom_http_headers":    map[string]string(m.CustomHttpHeaders),
+		"keyword_type":           "",
+		"keyword_case_sensitive": false,
+		"keyword_value":          "",
+		"post_type":              "",
+		"post_value":             "",
+		"post_content_type":      "",
+	}
+
+	if mType == "heartbeat" {
+		attributes["url"] = ""
+		attributes["heartbeat_url"] = heartbeatURL(m.Url)
+	}
+
+	if intToString(monitorSubType, int(m.SubType)) == "custom" {
+		attributes["port"] = int(m.Port)
+	}
+
+	if mType == "http" || mType == "keyword" {
+		attributes["http_method"] = intToString(monitorHTTPMethodType, int(m.HttpMethod))
+	}
+
+	if m.PostValue != "" {
+		attributes["post_type"] = intToString(monitorPostType, int(m.PostType))
+		attributes["post_value"] = string(m.PostValue)
+		// Not every account gets post_content_type back; keep the planned
+		// value then instead of assuming text/html.
+		delete(attributes, "post_content_type")
+		if m.PostContentType != nil {
+			attributes["post_content_type"] = intToString(monitorPostContentType, int(*m.PostContentType))
+		}
+	}
+
+	if m.SSL != nil {
+		attributes["ignore_ssl_errors"] = m.SSL.IgnoreErrors == 1
+	}
+
+	if mType == "keyword" {
+		attributes["keyword_type"] = intToString(monitorKeywordType, int(m.KeywordType))
+		attributes["keyword_case_sensitive"] = m.KeywordCaseType == 0
+		attributes["keyword_value"] = m.KeywordValue
+	}
+
+	up, down, err := decodeCustomHTTPStatuses(string(m.CustomHttpStatuses))
+	if err != nil {
+		return fmt.Errorf("error reading custom_http_statuses for resource %s: %s", d.Id(), err.Error())
+	}
+	rawStatuses := []map[string]interface{}{}
+	if len(up) > 0 || len(down) > 0 {
+		rawStatuses = append(rawStatuses, map[string]interface{}{
+			"up":   up,
+			"down": down,
+		})
+	}
+	attributes["custom_http_statuses"] = rawStatuses
+
+	mWindowIds := make([]int, len(m.MWindows))
+	for i, w := range m.MWindows {
+		mWindowIds[i] = w.Id
+	}
+	attributes["maintenance_window_ids"] = mWindowIds
+
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting %s for resource %s: %s", k, d.Id(), err.Error())
+		}
+	}
+
+	return nil
 }
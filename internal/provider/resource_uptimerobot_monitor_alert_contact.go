@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/exileed/uptimerobotapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// numericID matches the numeric IDs the API assigns to monitors and alert
+// contacts.
+var numericID = regexp.MustCompile(`^[0-9]+$`)
+
+// monitorAlertContactLocks serialises the read-modify-write of a monitor's
+// alert contact list, so attachments to the same monitor created in parallel
+// do not overwrite each other.
+var monitorAlertContactLocks = newMutexKV()
+
+func resourceMonitorAlertContact() *schema.Resource {
+	return &schema.Resource{
+		Description: "Attaches a single alert contact to an Uptimerobot monitor",
+
+		CreateContext: resourceMonitorAlertContactCreate,
+		ReadContext:   resourceMonitorAlertContactRead,
+		UpdateContext: resourceMonitorAlertContactUpdate,
+		DeleteContext: resourceMonitorAlertContactDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMonitorAlertContactImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"monitor_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(numericID, "must be a numeric monitor ID"),
+				Description:  "The ID of the monitor.",
+			},
+			"alert_contact_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(numericID, "must be a numeric alert contact ID"),
+				Description:  "The ID of the alert contact.",
+			},
+			"threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of minutes the monitor has to be down before the alert contact is notified.",
+			},
+			"recurrence": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Repeat the notification every this many minutes while the monitor stays down. 0 disables repeats.",
+			},
+		},
+	}
+}
+
+func resourceMonitorAlertContactCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	monitorID := d.Get("monitor_id").(string)
+	alertContactID := d.Get("alert_contact_id").(string)
+
+	err := updateMonitorAlertContacts(ctx, meta.(uptimerobotapi.Client), monitorID, d.Timeout(schema.TimeoutCreate), func(contacts []uptimerobotapi.AlertContactMonitor) ([]uptimerobotapi.AlertContactMonitor, error) {
+		if findMonitorAlertContact(contacts, alertContactID) >= 0 {
+			return nil, fmt.Errorf("alert contact %s is already attached to monitor %s, import it with terraform import and the ID %s/%s", alertContactID, monitorID, monitorID, alertContactID)
+		}
+
+		return append(contacts, expandMonitorAlertContact(d)), nil
+	})
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	d.SetId(monitorID + "/" + alertContactID)
+
+	return resourceMonitorAlertContactRead(ctx, d, meta)
+}
+
+func resourceMonitorAlertContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+
+	monitorID, alertContactID, err := parseMonitorAlertContactID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var contacts []uptimerobotapi.AlertContactMonitor
+
+	err = retryTime(ctx, func() error {
+		contacts, err = getMonitorAlertContacts(ctx, client, monitorID)
+		return err
+	}, d.Timeout(schema.TimeoutRead))
+
+	if isNotFoundError(err) {
+		log.Printf("[WARN] Monitor %s not found, removing MonitorAlertContact %s from state", monitorID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	i := findMonitorAlertContact(contacts, alertContactID)
+	if i < 0 {
+		log.Printf("[WARN] MonitorAlertContact %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("monitor_id", monitorID)
+	d.Set("alert_contact_id", alertContactID)
+	d.Set("threshold", contacts[i].Threshold)
+	d.Set("recurrence", contacts[i].Recurrence)
+
+	return nil
+}
+
+func resourceMonitorAlertContactUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	monitorID := d.Get("monitor_id").(string)
+	alertContactID := d.Get("alert_contact_id").(string)
+
+	err := updateMonitorAlertContacts(ctx, meta.(uptimerobotapi.Client), monitorID, d.Timeout(schema.TimeoutUpdate), func(contacts []uptimerobotapi.AlertContactMonitor) ([]uptimerobotapi.AlertContactMonitor, error) {
+		i := findMonitorAlertContact(contacts, alertContactID)
+		if i < 0 {
+			return append(contacts, expandMonitorAlertContact(d)), nil
+		}
+
+		contacts[i] = expandMonitorAlertContact(d)
+		return contacts, nil
+	})
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	return resourceMonitorAlertContactRead(ctx, d, meta)
+}
+
+func resourceMonitorAlertContactDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	monitorID := d.Get("monitor_id").(string)
+	alertContactID := d.Get("alert_contact_id").(string)
+
+	err := updateMonitorAlertContacts(ctx, meta.(uptimerobotapi.Client), monitorID, d.Timeout(schema.TimeoutDelete), func(contacts []uptimerobotapi.AlertContactMonitor) ([]uptimerobotapi.AlertContactMonitor, error) {
+		i := findMonitorAlertContact(contacts, alertContactID)
+		if i < 0 {
+			return nil, nil
+		}
+
+		return append(contacts[:i], contacts[i+1:]...), nil
+	})
+
+	// A deleted monitor takes its alert contacts with it.
+	if err != nil && !isNotFoundError(err) {
+		return diag.Errorf(err.Error())
+	}
+
+	return nil
+}
+
+func resourceMonitorAlertContactImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	monitorID, alertContactID, err := parseMonitorAlertContactID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("monitor_id", monitorID)
+	d.Set("alert_contact_id", alertContactID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseMonitorAlertContactID splits a "<monitor ID>/<alert contact ID>"
+// resource ID.
+func parseMonitorAlertContactID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || !numericID.MatchString(parts[0]) || !numericID.MatchString(parts[1]) {
+		return "", "", fmt.Errorf("unexpected ID %q, expected <monitor ID>/<alert contact ID>", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandMonitorAlertContact(d *schema.ResourceData) uptimerobotapi.AlertContactMonitor {
+	return uptimerobotapi.AlertContactMonitor{
+		Id:         d.Get("alert_contact_id").(string),
+		Threshold:  d.Get("threshold").(int),
+		Recurrence: d.Get("recurrence").(int),
+	}
+}
+
+func findMonitorAlertContact(contacts []uptimerobotapi.AlertContactMonitor, alertContactID string) int {
+	for i, ac := range contacts {
+		if ac.Id == alertContactID {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// getMonitorAlertContacts returns the alert contacts attached to a monitor.
+func getMonitorAlertContacts(ctx context.Context, client uptimerobotapi.Client, monitorID string) ([]uptimerobotapi.AlertContactMonitor, error) {
+	m, err := getMonitors(ctx, client, getMonitorsParams{
+		Monitors:      &monitorID,
+		AlertContacts: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(m.Monitors) == 0 {
+		return nil, uptimerobotapi.APIError{
+			Message: fmt.Sprintf("monitor %s not found", monitorID),
+		}
+	}
+
+	return m.Monitors[0].AlertContacts, nil
+}
+
+// updateMonitorAlertContacts replaces the alert contacts of a monitor with
+// the list modify derives from the current one. A nil list from modify
+// leaves the monitor untouched. The monitor is locked for the duration, so
+// only attachments managed by other Terraform runs can race with it.
+func updateMonitorAlertContacts(ctx context.Context, client uptimerobotapi.Client, monitorID string, timeout time.Duration, modify func([]uptimerobotapi.AlertContactMonitor) ([]uptimerobotapi.AlertContactMonitor, error)) error {
+	idInt, err := strconv.Atoi(monitorID)
+	if err != nil {
+		return err
+	}
+
+	monitorAlertContactLocks.Lock(monitorID)
+	defer monitorAlertContactLocks.Unlock(monitorID)
+
+	return retryTime(ctx, func() error {
+		contacts, err := getMonitorAlertContacts(ctx, client, monitorID)
+		if err != nil {
+			return err
+		}
+
+		contacts, err = modify(contacts)
+		if err != nil || contacts == nil {
+			return err
+		}
+
+		alertContactStr := encodeMonitorAlertContacts(contacts)
+
+		_, err = editMonitor(ctx, client, monitorParams{
+			Id:            idInt,
+			AlertContacts: &alertContactStr,
+		})
+		return err
+	}, timeout)
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestUptimeRobotResourceMonitorAlertContact(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMonitorAlertContact(0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("uptimerobot_monitor_alert_contact.test", "monitor_id", "uptimerobot_monitor.test", "id"),
+					resource.TestCheckResourceAttrPair("uptimerobot_monitor_alert_contact.test", "alert_contact_id", "uptimerobot_alert_contact.test", "id"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor_alert_contact.test", "recurrence", "0"),
+				),
+			},
+			{
+				Config: testAccResourceMonitorAlertContact(30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor_alert_contact.test", "recurrence", "30"),
+				),
+			},
+			{
+				ResourceName:      "uptimerobot_monitor_alert_contact.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceMonitorAlertContact(recurrence int) string {
+	return fmt.Sprintf(`
+resource "uptimerobot_alert_contact" "test" {
+  friendly_name = "terraform monitor alert contact"
+  type          = "email"
+  value         = "terraform@example.com"
+}
+
+resource "uptimerobot_monitor" "test" {
+  friendly_name         = "terraform monitor alert contact"
+  type                  = "http"
+  url                   = "https://example.com"
+  ignore_alert_contacts = true
+}
+
+resource "uptimerobot_monitor_alert_contact" "test" {
+  monitor_id       = uptimerobot_monitor.test.id
+  alert_contact_id = uptimerobot_alert_contact.test.id
+  recurrence       = %d
+}
+`, recurrence)
+}
+
+func TestResourceMonitorAlertContactCreate(t *testing.T) {
+	var edited string
+
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		switch r.URL.Path {
+		case "/v2/getMonitors":
+			contacts := `{"id": "1", "threshold": 0, "recurrence": 0}`
+			if edited != "" {
+				contacts += `, {"id": "2", "threshold": 5, "recurrence": 30}`
+			}
+			fmt.Fprintf(w, `{"stat": "ok", "pagination": {"offset": 0, "limit": 50, "total": 1}, "monitors": [{"id": 42, "alert_contacts": [%s]}]}`, contacts)
+		case "/v2/editMonitor":
+			if r.PostForm.Get("friendly_name") != "" || r.PostForm.Get("url") != "" {
+				t.Errorf("expected only the alert contacts to be sent, got %v", r.PostForm)
+			}
+			edited = r.PostForm.Get("alert_contacts")
+			fmt.Fprint(w, `{"stat": "ok", "monitor": {"id": 42}}`)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	d := resourceMonitorAlertContact().TestResourceData()
+	d.Set("monitor_id", "42")
+	d.Set("alert_contact_id", "2")
+	d.Set("threshold", 5)
+	d.Set("recurrence", 30)
+
+	if diags := resourceMonitorAlertContactCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if expected := "1_0_0-2_5_30"; edited != expected {
+		t.Fatalf("expected alert_contacts %q, got %q", expected, edited)
+	}
+	if expected := "42/2"; d.Id() != expected {
+		t.Fatalf("expected id %q, got %q", expected, d.Id())
+	}
+}
@@ -0,0 +1,943 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUptimeRobotResourceMonitorKeyword(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMonitorKeyword,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "type", "keyword"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "keyword_type", "not_exists"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "keyword_case_sensitive", "true"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "keyword_value", "Example Domain"),
+				),
+			},
+			{
+				ResourceName:            "uptimerobot_monitor.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"id"},
+			},
+			{
+				Config:      testAccResourceMonitorKeywordOnHTTP,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("keyword_type: only valid for keyword monitors"),
+			},
+		},
+	})
+}
+
+func TestUptimeRobotResourceMonitorHTTPMethod(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMonitorHTTPMethod,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "http_method", "post"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "post_type", "raw_json"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "post_content_type", "application/json"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "post_value", `{"query":"{ health }"}`),
+				),
+			},
+			{
+				Config:      testAccResourceMonitorHTTPMethodGetWithBody,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("post_type: a request body requires http_method"),
+			},
+		},
+	})
+}
+
+func TestUptimeRobotResourceMonitorCustomHTTPHeaders(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMonitorCustomHTTPHeaders(`X-Tenant = "terraform"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "custom_http_headers.%", "1"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "custom_http_headers.X-Tenant", "terraform"),
+				),
+			},
+			{
+				Config: testAccResourceMonitorCustomHTTPHeaders(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "custom_http_headers.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestUptimeRobotResourceMonitorCustomHTTPStatuses(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMonitorCustomHTTPStatuses,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "custom_http_statuses.0.up.#", "2"),
+					resource.TestCheckTypeSetElemAttr("uptimerobot_monitor.test", "custom_http_statuses.0.up.*", "401"),
+					resource.TestCheckTypeSetElemAttr("uptimerobot_monitor.test", "custom_http_statuses.0.down.*", "429"),
+				),
+			},
+			{
+				Config:      testAccResourceMonitorCustomHTTPStatusesOverlap,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("status code 429 is listed as both up and down"),
+			},
+		},
+	})
+}
+
+func TestUptimeRobotResourceMonitorMaintenanceWindows(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMonitorMaintenanceWindows(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "maintenance_window_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("uptimerobot_monitor.test", "maintenance_window_ids.*", "uptimerobot_maintenance_window.test", "id"),
+				),
+			},
+			{
+				Config: testAccResourceMonitorMaintenanceWindows(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "maintenance_window_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestUptimeRobotResourceMonitorPaused(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMonitorPaused(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "paused", "true"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "status", "paused"),
+				),
+			},
+			{
+				Config: testAccResourceMonitorPaused(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "paused", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceMonitorCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		name   string
+		state  map[string]string
+		config map[string]interface{}
+		err    string
+	}{
+		{
+			name:   "valid http",
+			config: map[string]interface{}{"type": "http", "url": "https://example.com"},
+		},
+		{
+			name:   "valid custom port",
+			config: map[string]interface{}{"type": "port", "url": "example.com", "sub_type": "custom", "port": 8080},
+		},
+		{
+			name:   "port on http",
+			config: map[string]interface{}{"type": "http", "url": "https://example.com", "port": 8080},
+			err:    "port: only valid for port monitors",
+		},
+		{
+			name:   "sub_type on ping",
+			config: map[string]interface{}{"type": "ping", "url": "example.com", "sub_type": "http"},
+			err:    "sub_type: only valid for port monitors",
+		},
+		{
+			name:   "port monitor without sub_type",
+			config: map[string]interface{}{"type": "port", "url": "example.com"},
+			err:    "sub_type: required for port monitors",
+		},
+		{
+			name:   "custom port without port",
+			config: map[string]interface{}{"type": "port", "url": "example.com", "sub_type": "custom"},
+			err:    "port: required when sub_type is \"custom\"",
+		},
+		{
+			name:   "auth type without credentials",
+			config: map[string]interface{}{"type": "http", "url": "https://example.com", "http_auth_type": "basic"},
+			err:    "http_username: required when http_auth_type is set",
+		},
+		{
+			name:   "timeout above interval",
+			config: map[string]interface{}{"type": "http", "url": "https://example.com", "interval": 60, "timeout": 60},
+			err:    "timeout: must be lower than interval",
+		},
+		{
+			name:   "http without scheme",
+			config: map[string]interface{}{"type": "http", "url": "example.com"},
+			err:    "url: http monitors require an http:// or https:// URL",
+		},
+		{
+			name:   "ping with scheme",
+			config: map[string]interface{}{"type": "ping", "url": "https://example.com"},
+			err:    "url: ping monitors require a host name or IP address",
+		},
+		{
+			name:   "keyword without value",
+			config: map[string]interface{}{"type": "keyword", "url": "https://example.com", "keyword_type": "exists"},
+			err:    "keyword_value: required for keyword monitors",
+		},
+		{
+			name: "duplicate alert contact",
+			config: map[string]interface{}{"type": "http", "url": "https://example.com", "alert_contact": []interface{}{
+				map[string]interface{}{"id": "1234", "threshold": 0},
+				map[string]interface{}{"id": "1234", "threshold": 5},
+			}},
+			err: "alert_contact: contact 1234 is listed more than once",
+		},
+		{
+			name:   "http to ping keeps no http_method",
+			state:  map[string]string{"friendly_name": "test", "type": "http", "url": "https://example.com", "http_method": "head"},
+			config: map[string]interface{}{"type": "ping", "url": "example.com"},
+		},
+		{
+			name:   "http_method on ping",
+			state:  map[string]string{"friendly_name": "test", "type": "http", "url": "https://example.com", "http_method": "head"},
+			config: map[string]interface{}{"type": "ping", "url": "example.com", "http_method": "get"},
+			err:    "http_method: only valid for http and keyword monitors",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]interface{}{"friendly_name": "test"}
+			for k, v := range tc.config {
+				config[k] = v
+			}
+
+			var state *terraform.InstanceState
+			if tc.state != nil {
+				state = &terraform.InstanceState{
+					ID:         "42",
+					Attributes: tc.state,
+					RawConfig:  testRawConfig(t, resourceMonitor(), config),
+				}
+			}
+
+			_, err := resourceMonitor().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestResourceMonitorReadNotFound(t *testing.T) {
+	cases := map[string]string{
+		"empty list":   `{"stat": "ok", "pagination": {"offset": 0, "limit": 50, "total": 0}, "monitors": []}`,
+		"fail payload": `{"stat": "fail", "error": {"type": "not_found", "parameter_name": "id", "passed_value": "42", "message": "monitor not found."}}`,
+	}
+
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, body)
+			})
+
+			d := resourceMonitor().TestResourceData()
+			d.SetId("42")
+
+			if diags := resourceMonitorRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if d.Id() != "" {
+				t.Fatalf("expected the monitor to be removed from state, got id %q", d.Id())
+			}
+		})
+	}
+}
+
+func TestResourceMonitorStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id": "42",
+		"alert_contact": []interface{}{
+			map[string]interface{}{"id": "3", "threshold": 0, "recurrence": 0},
+			map[string]interface{}{"id": "1", "threshold": 5, "recurrence": 10},
+			map[string]interface{}{"id": "3", "threshold": 1, "recurrence": 0},
+		},
+	}
+
+	expected := map[string]interface{}{
+		"id": "42",
+		"alert_contact": []interface{}{
+			map[string]interface{}{"id": "3", "threshold": 0, "recurrence": 0},
+			map[string]interface{}{"id": "1", "threshold": 5, "recurrence": 10},
+		},
+	}
+
+	actual, err := resourceMonitorStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
+	}
+}
+
+// TestResourceMonitorStateUpgradeV0Type decodes a version 0 state with the
+// type of the state upgrader, which must not pick up attributes added later.
+func TestResourceMonitorStateUpgradeV0Type(t *testing.T) {
+	state := `{
+		"id": "42", "friendly_name": "test", "url": "https://example.com", "heartbeat_url": "", "type": "http",
+		"sub_type": "", "port": 0, "interval": 300, "timeout": 30, "keyword_type": "", "keyword_case_sensitive": false,
+		"keyword_value": "", "http_username": "", "http_password": "", "http_auth_type": "", "http_method": "head",
+		"post_type": "", "post_value": "", "post_content_type": "", "custom_http_headers": {}, "custom_http_statuses": [],
+		"paused": false, "status": "up", "ignore_ssl_errors": false,
+		"alert_contact": [{"id": "3", "threshold": 0, "recurrence": 0}]
+	}`
+
+	var attributes map[string]interface{}
+	if err := json.Unmarshal([]byte(state), &attributes); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ty := resourceMonitor().StateUpgraders[0].Type
+	if _, err := ctyjson.Unmarshal([]byte(state), ty); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if actual := len(ty.AttributeTypes()); actual != len(attributes) {
+		t.Fatalf("expected %d attributes in the version 0 type, got %d", len(attributes), actual)
+	}
+}
+
+func TestResourceMonitorImport(t *testing.T) {
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		// Serve one monitor per page to exercise pagination.
+		monitors := []string{
+			`{"id": 1, "friendly_name": "Checkout API", "url": "https://api.example.com/health", "type": 1}`,
+			`{"id": 2, "friendly_name": "Checkout API", "url": "https://api.example.com/health", "type": 2}`,
+			`{"id": 3, "friendly_name": "Storefront", "url": "https://example.com", "type": 1}`,
+		}
+		offset, _ := strconv.Atoi(r.Form.Get("offset"))
+		fmt.Fprintf(w, `{"stat": "ok", "pagination": {"offset": %d, "limit": 1, "total": 3}, "monitors": [%s]}`, offset, monitors[offset])
+	})
+
+	cases := []struct {
+		importID string
+		id       string
+		err      string
+	}{
+		{importID: "42", id: "42"},
+		{importID: "name=Storefront", id: "3"},
+		{importID: "keyword:https://api.example.com/health", id: "2"},
+		{importID: "url=https://api.example.com/health", err: "matches 2 monitors, import one of them by ID instead: 1 (Checkout API, http https://api.example.com/health); 2 (Checkout API, keyword"},
+		{importID: "name=Unknown", err: "no monitor matches import ID"},
+		{importID: "dns:example.com", err: "unexpected import ID"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.importID, func(t *testing.T) {
+			d := resourceMonitor().TestResourceData()
+			d.SetId(tc.importID)
+
+			result, err := resourceMonitorImport(context.Background(), d, client)
+
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(result) != 1 || result[0].Id() != tc.id {
+				t.Fatalf("expected monitor %s, got %v", tc.id, result)
+			}
+		})
+	}
+}
+
+func TestFillMonitorHTTPPassword(t *testing.T) {
+	cases := map[string]string{
+		"":         "secret",
+		"********": "secret",
+		"changed":  "changed",
+	}
+
+	for returned, expected := range cases {
+		d := schema.TestResourceDataRaw(t, resourceMonitorSchema(), map[string]interface{}{
+			"friendly_name": "test",
+			"type":          "http",
+			"url":           "https://example.com",
+			"http_password": "secret",
+		})
+
+		if err := fillMonitor(d, monitor{Type: 1, HttpPassword: returned}); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if actual := d.Get("http_password").(string); actual != expected {
+			t.Fatalf("API returned %q: expected http_password %q, got %q", returned, expected, actual)
+		}
+	}
+}
+
+func TestResourceMonitorRemoveHTTPAuth(t *testing.T) {
+	var sent url.Values
+
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		switch r.URL.Path {
+		case "/v2/editMonitor":
+			sent = r.PostForm
+			fmt.Fprint(w, `{"stat": "ok", "monitor": {"id": 42}}`)
+		case "/v2/getMonitors":
+			fmt.Fprint(w, `{"stat": "ok", "pagination": {"offset": 0, "limit": 50, "total": 1}, "monitors": [
+				{"id": 42, "friendly_name": "test", "url": "https://example.com", "type": 1, "interval": 300, "timeout": 30}
+			]}`)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	state := &terraform.InstanceState{
+		ID: "42",
+		Attributes: map[string]string{
+			"id":             "42",
+			"friendly_name":  "test",
+			"type":           "http",
+			"url":            "https://example.com",
+			"interval":       "300",
+			"timeout":        "30",
+			"http_username":  "admin",
+			"http_password":  "secret",
+			"http_auth_type": "basic",
+		},
+	}
+
+	testApply(t, resourceMonitor(), state, map[string]interface{}{
+		"friendly_name": "test",
+		"type":          "http",
+		"url":           "https://example.com",
+	}, client)
+
+	for k, v := range map[string]string{
+		"http_username":  "",
+		"http_password":  "",
+		"http_auth_type": "0",
+	} {
+		if actual, ok := sent[k]; !ok || len(actual) != 1 || actual[0] != v {
+			t.Errorf("expected %s param %q, got %v", k, v, actual)
+		}
+	}
+}
+
+func TestFillMonitorPostContentType(t *testing.T) {
+	cases := map[string]string{
+		`{"id": 42, "type": 1, "post_type": 2, "post_value": "{}", "post_content_type": 1}`: "application/json",
+		`{"id": 42, "type": 1, "post_type": 2, "post_value": "{}", "post_content_type": 0}`: "text/html",
+		`{"id": 42, "type": 1, "post_type": 2, "post_value": "{}"}`:                         "application/json",
+	}
+
+	for body, expected := range cases {
+		var m monitor
+		if err := json.Unmarshal([]byte(body), &m); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		d := schema.TestResourceDataRaw(t, resourceMonitorSchema(), map[string]interface{}{
+			"friendly_name":     "test",
+			"type":              "http",
+			"url":               "https://example.com",
+			"http_method":       "post",
+			"post_type":         "raw_json",
+			"post_value":        "{}",
+			"post_content_type": "application/json",
+		})
+
+		if err := fillMonitor(d, m); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if actual := d.Get("post_content_type").(string); actual != expected {
+			t.Fatalf("API returned %s: expected post_content_type %q, got %q", body, expected, actual)
+		}
+	}
+}
+
+func TestResourceMonitorPostContentTypeDefault(t *testing.T) {
+	config := map[string]interface{}{
+		"friendly_name": "test",
+		"type":          "http",
+		"url":           "https://example.com",
+		"http_method":   "post",
+		"post_type":     "raw_json",
+		"post_value":    "{}",
+	}
+
+	// The state after reading back a monitor created from config, which
+	// the API reports with the default content type.
+	state := &terraform.InstanceState{
+		ID: "42",
+		Attributes: map[string]string{
+			"friendly_name":     "test",
+			"type":              "http",
+			"url":               "https://example.com",
+			"http_method":       "post",
+			"post_type":         "raw_json",
+			"post_value":        "{}",
+			"post_content_type": "text/html",
+		},
+		RawConfig: testRawConfig(t, resourceMonitor(), config),
+	}
+
+	diff, err := resourceMonitor().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diff != nil && diff.Attributes["post_content_type"] != nil {
+		t.Fatalf("expected no change of post_content_type, got %#v", diff.Attributes["post_content_type"])
+	}
+}
+
+func TestResourceMonitorHTTPMethodDefault(t *testing.T) {
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		method   string
+		expected string
+	}{
+		{
+			name:     "http method removed",
+			config:   map[string]interface{}{"type": "http"},
+			method:   "post",
+			expected: "head",
+		},
+		{
+			name:     "keyword method removed",
+			config:   map[string]interface{}{"type": "keyword", "keyword_type": "exists", "keyword_value": "Welcome"},
+			method:   "head",
+			expected: "get",
+		},
+		{
+			name:   "http default",
+			config: map[string]interface{}{"type": "http"},
+			method: "head",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]interface{}{"friendly_name": "test", "url": "https://example.com"}
+			for k, v := range tc.config {
+				config[k] = v
+			}
+
+			attributes := map[string]string{"friendly_name": "test", "url": "https://example.com", "http_method": tc.method}
+			for k, v := range tc.config {
+				attributes[k] = v.(string)
+			}
+
+			state := &terraform.InstanceState{
+				ID:         "42",
+				Attributes: attributes,
+				RawConfig:  testRawConfig(t, resourceMonitor(), config),
+			}
+
+			diff, err := resourceMonitor().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			var actual *terraform.ResourceAttrDiff
+			if diff != nil {
+				actual = diff.Attributes["http_method"]
+			}
+
+			if tc.expected == "" {
+				if actual != nil {
+					t.Fatalf("expected no change of http_method, got %#v", actual)
+				}
+				return
+			}
+			if actual == nil || actual.New != tc.expected {
+				t.Fatalf("expected http_method to change to %q, got %#v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestResourceMonitorCustomHTTPHeaders(t *testing.T) {
+	config := map[string]interface{}{
+		"friendly_name":       "test",
+		"type":                "http",
+		"url":                 "https://example.com",
+		"custom_http_headers": map[string]interface{}{"X-Tenant": "terraform", "Accept": "application/json"},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceMonitorSchema(), config)
+
+	request, err := buildMonitorParams(d)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if request.CustomHttpHeaders == nil || *request.CustomHttpHeaders != `{"Accept":"application/json","X-Tenant":"terraform"}` {
+		t.Fatalf("unexpected custom_http_headers param: %v", stringValue(request.CustomHttpHeaders))
+	}
+
+	// Removing every header sends an empty object, which clears them.
+	var sent url.Values
+
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		switch r.URL.Path {
+		case "/v2/editMonitor":
+			sent = r.PostForm
+			fmt.Fprint(w, `{"stat": "ok", "monitor": {"id": 42}}`)
+		case "/v2/getMonitors":
+			fmt.Fprint(w, `{"stat": "ok", "pagination": {"offset": 0, "limit": 50, "total": 1}, "monitors": [
+				{"id": 42, "friendly_name": "test", "url": "https://example.com", "type": 1, "interval": 300, "timeout": 30, "custom_http_headers": []}
+			]}`)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	state := &terraform.InstanceState{
+		ID: "42",
+		Attributes: map[string]string{
+			"id":                           "42",
+			"friendly_name":                "test",
+			"type":                         "http",
+			"url":                          "https://example.com",
+			"interval":                     "300",
+			"timeout":                      "30",
+			"custom_http_headers.%":        "1",
+			"custom_http_headers.X-Tenant": "terraform",
+		},
+	}
+
+	newState := testApply(t, resourceMonitor(), state, map[string]interface{}{
+		"friendly_name": "test",
+		"type":          "http",
+		"url":           "https://example.com",
+	}, client)
+
+	if actual := sent.Get("custom_http_headers"); actual != "{}" {
+		t.Fatalf("expected custom_http_headers param {}, got %q", actual)
+	}
+	if actual := newState.Attributes["custom_http_headers.%"]; actual != "0" {
+		t.Fatalf("expected no custom_http_headers in state, got %q", actual)
+	}
+
+	// The API returns an empty array instead of an empty object.
+	for body, expected := range map[string]map[string]interface{}{
+		`{"id": 42, "type": 1, "custom_http_headers": {"X-Tenant": "terraform"}}`: {"X-Tenant": "terraform"},
+		`{"id": 42, "type": 1, "custom_http_headers": []}`:                        {},
+	} {
+		var m monitor
+		if err := json.Unmarshal([]byte(body), &m); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		d := schema.TestResourceDataRaw(t, resourceMonitorSchema(), config)
+		if err := fillMonitor(d, m); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if actual := d.Get("custom_http_headers"); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("API returned %s: expected custom_http_headers %v, got %v", body, expected, actual)
+		}
+	}
+}
+
+func TestResourceMonitorMaintenanceWindows(t *testing.T) {
+	config := map[string]interface{}{
+		"friendly_name":          "test",
+		"type":                   "http",
+		"url":                    "https://example.com",
+		"maintenance_window_ids": []interface{}{7, 3},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceMonitorSchema(), config)
+
+	request, err := buildMonitorParams(d)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if request.MWindows == nil || *request.MWindows != "3-7" {
+		t.Fatalf("unexpected mwindows param: %v", stringValue(request.MWindows))
+	}
+
+	var m monitor
+	if err := json.Unmarshal([]byte(`{"id": 42, "type": 1, "mwindows": [
+		{"id": 3, "type": 1, "start_time": 1640383200, "duration": 60},
+		{"id": 7, "type": 3, "value": "2-4", "start_time": "22:30", "duration": 60}
+	]}`), &m); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceMonitorSchema(), map[string]interface{}{})
+	if err := fillMonitor(d, m); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if actual := joinInts(d.Get("maintenance_window_ids").(*schema.Set).List(), ","); actual != "3,7" {
+		t.Fatalf("expected maintenance_window_ids 3,7, got %s", actual)
+	}
+
+	// Removing every window sends an empty list, which detaches them.
+	var sent url.Values
+
+	client := testAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		switch r.URL.Path {
+		case "/v2/editMonitor":
+			sent = r.PostForm
+			fmt.Fprint(w, `{"stat": "ok", "monitor": {"id": 42}}`)
+		case "/v2/getMonitors":
+			fmt.Fprint(w, `{"stat": "ok", "pagination": {"offset": 0, "limit": 50, "total": 1}, "monitors": [
+				{"id": 42, "friendly_name": "test", "url": "https://example.com", "type": 1, "interval": 300, "timeout": 30, "mwindows": []}
+			]}`)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	state := &terraform.InstanceState{
+		ID: "42",
+		Attributes: map[string]string{
+			"id":                                "42",
+			"friendly_name":                     "test",
+			"type":                              "http",
+			"url":                               "https://example.com",
+			"interval":                          "300",
+			"timeout":                           "30",
+			"maintenance_window_ids.#":          "2",
+			"maintenance_window_ids.79273707":   "3",
+			"maintenance_window_ids.1624595439": "7",
+		},
+	}
+
+	newState := testApply(t, resourceMonitor(), state, map[string]interface{}{
+		"friendly_name": "test",
+		"type":          "http",
+		"url":           "https://example.com",
+	}, client)
+
+	if actual, ok := sent["mwindows"]; !ok || len(actual) != 1 || actual[0] != "" {
+		t.Fatalf("expected an empty mwindows param, got %v", actual)
+	}
+	if actual := newState.Attributes["maintenance_window_ids.#"]; actual != "0" {
+		t.Fatalf("expected no maintenance_window_ids in state, got %q", actual)
+	}
+}
+
+func TestCustomHTTPStatusesEncoding(t *testing.T) {
+	encoded := encodeCustomHTTPStatuses([]int{401, 302}, []int{429})
+	if encoded != "302:1_401:1_429:0" {
+		t.Fatalf("unexpected encoding: %s", encoded)
+	}
+
+	up, down, err := decodeCustomHTTPStatuses(encoded)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !reflect.DeepEqual(up, []int{302, 401}) || !reflect.DeepEqual(down, []int{429}) {
+		t.Fatalf("unexpected decoding: up %v, down %v", up, down)
+	}
+
+	if _, _, err := decodeCustomHTTPStatuses("404-0"); err == nil {
+		t.Fatal("expected an error for a malformed list")
+	}
+}
+
+func TestUptimeRobotResourceMonitorHeartbeat(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMonitorHeartbeat,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "type", "heartbeat"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "url", ""),
+					resource.TestMatchResourceAttr("uptimerobot_monitor.test", "heartbeat_url", regexp.MustCompile("^https://")),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceMonitorKeyword = `
+resource "uptimerobot_monitor" "test" {
+  friendly_name          = "terraform keyword test"
+  type                   = "keyword"
+  url                    = "https://example.com"
+  keyword_type           = "not_exists"
+  keyword_case_sensitive = true
+  keyword_value          = "Example Domain"
+}
+`
+
+const testAccResourceMonitorKeywordOnHTTP = `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "terraform keyword test"
+  type          = "http"
+  url           = "https://example.com"
+  keyword_type  = "exists"
+}
+`
+
+func testAccResourceMonitorPaused(paused bool) string {
+	return fmt.Sprintf(`
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "terraform paused test"
+  type          = "http"
+  url           = "https://example.com"
+  paused        = %t
+}
+`, paused)
+}
+
+func testAccResourceMonitorMaintenanceWindows(attached bool) string {
+	mWindowIds := "[]"
+	if attached {
+		mWindowIds = "[uptimerobot_maintenance_window.test.id]"
+	}
+
+	return fmt.Sprintf(`
+resource "uptimerobot_maintenance_window" "test" {
+  friendly_name = "terraform monitor windows test"
+  type          = "daily"
+  start_time    = "03:00"
+  duration      = 30
+}
+
+resource "uptimerobot_monitor" "test" {
+  friendly_name          = "terraform monitor windows test"
+  type                   = "http"
+  url                    = "https://example.com"
+  maintenance_window_ids = %s
+}
+`, mWindowIds)
+}
+
+const testAccResourceMonitorHeartbeat = `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "terraform heartbeat test"
+  type          = "heartbeat"
+  interval      = 3600
+}
+`
+
+const testAccResourceMonitorHTTPMethod = `
+resource "uptimerobot_monitor" "test" {
+  friendly_name     = "terraform http method test"
+  type              = "http"
+  url               = "https://example.com"
+  http_method       = "post"
+  post_type         = "raw_json"
+  post_content_type = "application/json"
+  post_value        = jsonencode({ query = "{ health }" })
+}
+`
+
+func testAccResourceMonitorCustomHTTPHeaders(headers string) string {
+	return fmt.Sprintf(`
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "terraform custom headers test"
+  type          = "http"
+  url           = "https://example.com"
+
+  custom_http_headers = {
+    %s
+  }
+}
+`, headers)
+}
+
+const testAccResourceMonitorHTTPMethodGetWithBody = `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "terraform http method test"
+  type          = "http"
+  url           = "https://example.com"
+  http_method   = "get"
+  post_type     = "raw_json"
+  post_value    = jsonencode({ query = "{ health }" })
+}
+`
+
+const testAccResourceMonitorCustomHTTPStatuses = `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "terraform custom statuses test"
+  type          = "http"
+  url           = "https://example.com"
+
+  custom_http_statuses {
+    up   = [302, 401]
+    down = [429]
+  }
+}
+`
+
+const testAccResourceMonitorCustomHTTPStatusesOverlap = `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "terraform custom statuses test"
+  type          = "http"
+  url           = "https://example.com"
+
+  custom_http_statuses {
+    up   = [429]
+    down = [429]
+  }
+}
+`
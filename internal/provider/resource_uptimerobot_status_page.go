@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/exileed/uptimerobotapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// pspType is the only status page type the API supports.
+const pspType = 1
+
+var pspSort = map[string]int{
+	"friendly_name_asc":  1,
+	"friendly_name_desc": 2,
+	"status_up_first":    3,
+	"status_down_first":  4,
+}
+
+var pspStatus = map[string]int{
+	"paused": 0,
+	"active": 1,
+}
+
+func resourceStatusPage() *schema.Resource {
+	return &schema.Resource{
+		Description: "Uptimerobot public status page resource",
+
+		CreateContext: resourceStatusPageCreate,
+		ReadContext:   resourceStatusPageRead,
+		UpdateContext: resourceStatusPageUpdate,
+		DeleteContext: resourceStatusPageDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceStatusPageCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"friendly_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The friendly name of the status page.",
+			},
+			"monitor_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the monitors shown on the status page. Conflicts with `all_monitors`.",
+			},
+			"all_monitors": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Show every monitor of the account, including ones created later. Conflicts with `monitor_ids`.",
+			},
+			"custom_domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The custom domain the status page is served on. Point a CNAME record for it at `stats.uptimerobot.com`.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password protecting the status page. The API does not return it, so the configured value is kept in state.",
+			},
+			"sort": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "friendly_name_asc",
+				ValidateFunc: validation.StringInSlice(mapKeys(pspSort), false),
+				Description:  "The order of the monitors: `friendly_name_asc`, `friendly_name_desc`, `status_up_first` or `status_down_first`.",
+			},
+			"hide_url_links": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Hide the URLs of the monitors on the status page.",
+			},
+			"paused": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the status page is paused, making it unavailable.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"standard_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the status page on uptimerobot.com.",
+			},
+			"custom_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the status page on `custom_domain`.",
+			},
+		},
+	}
+}
+
+func resourceStatusPageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+
+	request := buildStatusPageParams(d)
+	request.Type = pspType
+	request.Status = pspStatusParam(d.Get("paused").(bool))
+
+	var page *pspSingleResp
+	var err error
+
+	err = retryTime(ctx, func() error {
+		page, err = newPSP(ctx, client, request)
+		return err
+	}, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	d.SetId(strconv.Itoa(page.PSP.Id))
+
+	return resourceStatusPageRead(ctx, d, meta)
+}
+
+func resourceStatusPageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+	id := d.Id()
+
+	request := getPSPsParams{
+		PSPs: &id,
+	}
+
+	var p *pspsResp
+	var err error
+
+	err = retryTime(ctx, func() error {
+		p, err = getPSPs(ctx, client, request)
+		return err
+	}, d.Timeout(schema.TimeoutRead))
+
+	if isNotFoundError(err) || (err == nil && len(p.PSPs) == 0) {
+		log.Printf("[WARN] StatusPage %s not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	if err := fillStatusPage(d, p.PSPs[0]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceStatusPageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+
+	id := d.Id()
+
+	idInt, err := strconv.Atoi(id)
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	request := buildStatusPageParams(d)
+	request.Id = idInt
+
+	if d.HasChange("paused") {
+		request.Status = pspStatusParam(d.Get("paused").(bool))
+	}
+
+	err = retryTime(ctx, func() error {
+		_, err = editPSP(ctx, client, request)
+		return err
+	}, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	return resourceStatusPageRead(ctx, d, meta)
+}
+
+func resourceStatusPageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(uptimerobotapi.Client)
+
+	id := d.Id()
+
+	idInt, err := strconv.Atoi(id)
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	err = retryTime(ctx, func() error {
+		_, err = deletePSP(ctx, client, idInt)
+		return err
+	}, d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return diag.Errorf(err.Error())
+	}
+
+	return nil
+}
+
+func resourceStatusPageCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.HasChange("custom_domain") {
+		if err := d.SetNewComputed("custom_url"); err != nil {
+			return err
+		}
+	}
+
+	if !d.NewValueKnown("monitor_ids") {
+		return nil
+	}
+
+	hasMonitors := d.Get("monitor_ids").(*schema.Set).Len() > 0
+	allMonitors := d.Get("all_monitors").(bool)
+
+	if allMonitors && hasMonitors {
+		return fmt.Errorf("monitor_ids: conflicts with all_monitors, which already shows every monitor")
+	}
+	if !allMonitors && !hasMonitors {
+		return fmt.Errorf("monitor_ids: required unless all_monitors is set")
+	}
+
+	return nil
+}
+
+// buildStatusPageParams collects the parameters shared by newPSP and editPSP
+// from the resource configuration.
+func buildStatusPageParams(d *schema.ResourceData) pspParams {
+	// The API takes "0" for all monitors and a dash separated list otherwise.
+	monitors := "0"
+	if !d.Get("all_monitors").(bool) {
+		monitors = joinInts(d.Get("monitor_ids").(*schema.Set).List(), "-")
+	}
+
+	sort := pspSort[d.Get("sort").(string)]
+	hideURLLinks := d.Get("hide_url_links").(bool)
+
+	request := pspParams{
+		FriendlyName: d.Get("friendly_name").(string),
+		Monitors:     monitors,
+		Sort:         &sort,
+		HideURLLinks: &hideURLLinks,
+	}
+
+	// custom_domain and password are sent when set or changed, so removing
+	// them from the configuration clears them.
+	if customDomain, ok := d.GetOk("custom_domain"); ok || d.HasChange("custom_domain") {
+		customDomainString := customDomain.(string)
+		request.CustomDomain = &customDomainString
+	}
+
+	if password, ok := d.GetOk("password"); ok || d.HasChange("password") {
+		passwordString := password.(string)
+		request.Password = &passwordString
+	}
+
+	return request
+}
+
+// pspStatusParam returns the status that pauses or activates a status page.
+func pspStatusParam(paused bool) *int {
+	status := pspStatus["active"]
+	if paused {
+		status = pspStatus["paused"]
+	}
+	return &status
+}
+
+func fillStatusPage(d *schema.ResourceData, p psp) error {
+	d.Set("friendly_name", p.FriendlyName)
+	d.Set("all_monitors", p.Monitors.All)
+	d.Set("custom_domain", p.CustomDomain)
+	d.Set("sort", intToString(pspSort, p.Sort))
+	d.Set("hide_url_links", p.HideURLLinks == 1)
+	d.Set("paused", p.Status == pspStatus["paused"])
+	d.Set("status", intToString(pspStatus, p.Status))
+	d.Set("standard_url", p.StandardURL)
+	d.Set("custom_url", p.CustomURL)
+
+	if !isMaskedSecret(p.Password) {
+		d.Set("password", p.Password)
+	}
+
+	if err := d.Set("monitor_ids", p.Monitors.Ids); err != nil {
+		return fmt.Errorf("error setting monitor_ids for resource %s: %s", d.Id(), err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUptimeRobotResourceStatusPage(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceStatusPage,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_status_page.test", "friendly_name", "terraform status page"),
+					resource.TestCheckResourceAttr("uptimerobot_status_page.test", "monitor_ids.#", "1"),
+					resource.TestCheckResourceAttr("uptimerobot_status_page.test", "all_monitors", "false"),
+					resource.TestCheckResourceAttr("uptimerobot_status_page.test", "sort", "status_down_first"),
+					resource.TestCheckResourceAttrSet("uptimerobot_status_page.test", "standard_url"),
+				),
+			},
+			{
+				ResourceName:            "uptimerobot_status_page.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config:      testAccResourceStatusPageWithoutMonitors,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("monitor_ids: required unless all_monitors is set"),
+			},
+		},
+	})
+}
+
+func TestPSPMonitorsUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		body     string
+		expected pspMonitors
+	}{
+		{`0`, pspMonitors{All: true}},
+		{`"0"`, pspMonitors{All: true}},
+		{`42`, pspMonitors{Ids: []int{42}}},
+		{`"42"`, pspMonitors{Ids: []int{42}}},
+		{`"42-7"`, pspMonitors{Ids: []int{42, 7}}},
+		{`[42, 7]`, pspMonitors{Ids: []int{42, 7}}},
+	}
+
+	for _, tc := range cases {
+		var m pspMonitors
+		if err := json.Unmarshal([]byte(tc.body), &m); err != nil {
+			t.Errorf("%s: err: %s", tc.body, err)
+			continue
+		}
+		if !reflect.DeepEqual(m, tc.expected) {
+			t.Errorf("%s: expected %+v, got %+v", tc.body, tc.expected, m)
+		}
+	}
+
+	var m pspMonitors
+	if err := json.Unmarshal([]byte(`"42-x"`), &m); err == nil {
+		t.Error("expected an error for a malformed list")
+	}
+}
+
+func TestBuildStatusPageParams(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	boolPtr := func(v bool) *bool { return &v }
+	stringPtr := func(v string) *string { return &v }
+
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		expected pspParams
+	}{
+		{
+			name:   "all monitors",
+			config: map[string]interface{}{"friendly_name": "test", "all_monitors": true},
+			expected: pspParams{
+				FriendlyName: "test",
+				Monitors:     "0",
+				Sort:         intPtr(1),
+				HideURLLinks: boolPtr(false),
+			},
+		},
+		{
+			name:   "monitor ids",
+			config: map[string]interface{}{"friendly_name": "test", "monitor_ids": []interface{}{42, 7}, "sort": "status_down_first"},
+			expected: pspParams{
+				FriendlyName: "test",
+				Monitors:     "7-42",
+				Sort:         intPtr(4),
+				HideURLLinks: boolPtr(false),
+			},
+		},
+		{
+			name: "custom domain and password",
+			config: map[string]interface{}{
+				"friendly_name":  "test",
+				"all_monitors":   true,
+				"custom_domain":  "status.example.com",
+				"password":       "secret",
+				"hide_url_links": true,
+			},
+			expected: pspParams{
+				FriendlyName: "test",
+				Monitors:     "0",
+				CustomDomain: stringPtr("status.example.com"),
+				Password:     stringPtr("secret"),
+				Sort:         intPtr(1),
+				HideURLLinks: boolPtr(true),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceStatusPage().Schema, tc.config)
+
+			if actual := buildStatusPageParams(d); !reflect.DeepEqual(actual, tc.expected) {
+				t.Fatalf("expected %+v, got %+v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+const testAccResourceStatusPage = `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "terraform status page test"
+  type          = "http"
+  url           = "https://example.com"
+}
+
+resource "uptimerobot_status_page" "test" {
+  friendly_name = "terraform status page"
+  monitor_ids   = [uptimerobot_monitor.test.id]
+  sort          = "status_down_first"
+  password      = "terraform"
+}
+`
+
+const testAccResourceStatusPageWithoutMonitors = `
+resource "uptimerobot_status_page" "test" {
+  friendly_name = "terraform status page"
+}
+`
@@ -5,48 +5,65 @@ import (
 	"errors"
 	"fmt"
 	"github.com/exileed/uptimerobotapi"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"math/rand"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
-const timeoutMinutes = 60000
+// defaultTimeout is the default of every create, read, update and delete
+// timeout, including the retries done by retryTime.
+const defaultTimeout = 5 * time.Minute
 
-func retryTime(retryFunc func() error, minutes int) error {
+// retryTime calls retryFunc until it succeeds, fails with an error that is
+// not worth retrying, timeout elapses or ctx is cancelled.
+func retryTime(ctx context.Context, retryFunc func() error, timeout time.Duration) error {
 	wait := 2
-	return resource.RetryContext(context.Background(), time.Duration(minutes)*time.Minute, func() *resource.RetryError {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
 		err := retryFunc()
 
 		if err == nil {
 			return nil
 		}
 
-		log.Printf(fmt.Sprintf("[DEBUG] Error response %s", err.Error()))
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return resource.NonRetryableError(ctxErr)
+		}
+
+		log.Printf("[DEBUG] Error response %s", err.Error())
 
 		rand.Seed(time.Now().UnixNano())
 		randomNumberMilliseconds := rand.Intn(1001)
 
-		log.Printf(fmt.Sprintf("[DEBUG] Retrying server error code %d", randomNumberMilliseconds))
+		log.Printf("[DEBUG] Retrying server error code %d", randomNumberMilliseconds)
 
 		if apiErr, ok := err.(uptimerobotapi.APIError); ok && (apiErr.StatusCode == 500 || apiErr.StatusCode == 502 || apiErr.StatusCode == 503) {
-			timeSleep := time.Duration(wait)*time.Second + time.Duration(randomNumberMilliseconds)
+			timeSleep := time.Duration(wait)*time.Second + time.Duration(randomNumberMilliseconds)*time.Millisecond
 
-			log.Printf(fmt.Sprintf("[DEBUG] Retrying server error code. Sllep: %s", timeSleep))
-			time.Sleep(timeSleep)
+			log.Printf("[DEBUG] Retrying server error code. Sleep: %s", timeSleep)
+			if err := sleepContext(ctx, timeSleep); err != nil {
+				return resource.NonRetryableError(err)
+			}
 			wait = wait * 2
 			return resource.RetryableError(apiErr)
 		}
 
 		if apiErr, ok := err.(uptimerobotapi.APIError); ok && (apiErr.StatusCode == 409 || apiErr.StatusCode == 429) {
-			timeSleep := time.Duration(wait)*time.Second + time.Duration(randomNumberMilliseconds)
+			timeSleep := time.Duration(wait)*time.Second + time.Duration(randomNumberMilliseconds)*time.Millisecond
 
 			log.Printf("[DEBUG] Retrying quota/server error code...")
-			log.Printf(fmt.Sprintf("[DEBUG] Retrying quota/server error code %s", timeSleep))
+			log.Printf("[DEBUG] Retrying quota/server error code %s", timeSleep)
 
-			time.Sleep(timeSleep)
+			if err := sleepContext(ctx, timeSleep); err != nil {
+				return resource.NonRetryableError(err)
+			}
 			wait = wait * 2
 			return resource.RetryableError(apiErr)
 		}
@@ -69,6 +86,55 @@ func retryTime(retryFunc func() error, minutes int) error {
 	})
 }
 
+// pauseAfterCreate pauses a newly created resource whose paused attribute is
+// set. The API's new* methods take no status, so pause is expected to call
+// the matching edit* method with the paused status.
+func pauseAfterCreate(ctx context.Context, d *schema.ResourceData, pause func() error) error {
+	if !d.Get("paused").(bool) {
+		return nil
+	}
+
+	return retryTime(ctx, pause, d.Timeout(schema.TimeoutCreate))
+}
+
+// sleepContext waits for d, returning early with the context error when ctx
+// is cancelled.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isNotFoundError reports whether err is the API telling that the requested
+// object does not exist (any more), either as an HTTP 404 or as a "fail"
+// payload such as {"type": "not_found", "message": "monitor not found."}.
+func isNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var apiErr uptimerobotapi.APIError
+	var apiErrPtr *uptimerobotapi.APIError
+
+	switch {
+	case errors.As(err, &apiErr):
+	case errors.As(err, &apiErrPtr) && apiErrPtr != nil:
+		apiErr = *apiErrPtr
+	default:
+		return false
+	}
+
+	message := strings.ToLower(apiErr.Message)
+
+	return apiErr.StatusCode == 404 || strings.Contains(message, "not found") || strings.Contains(message, "not_found")
+}
+
 func mapKeys(m interface{}) []string {
 	v := reflect.ValueOf(m)
 	if v.Kind() != reflect.Map {
@@ -92,3 +158,124 @@ func intToString(m map[string]int, value int) string {
 	}
 	return ""
 }
+
+func stringInSlice(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func intInSlice(list []int, value int) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// joinInts joins a list of ints, as returned for a TypeSet or TypeList of
+// TypeInt, in ascending order.
+func joinInts(list []interface{}, sep string) string {
+	ints := make([]int, len(list))
+	for i, v := range list {
+		ints[i] = v.(int)
+	}
+	sort.Ints(ints)
+
+	parts := make([]string, len(ints))
+	for i, v := range ints {
+		parts[i] = strconv.Itoa(v)
+	}
+
+	return strings.Join(parts, sep)
+}
+
+// mutexKV is a set of mutexes addressed by key, used to serialise
+// read-modify-write updates of the same remote object.
+type mutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{store: make(map[string]*sync.Mutex)}
+}
+
+// Lock locks the mutex of key, creating it on first use.
+func (m *mutexKV) Lock(key string) {
+	m.get(key).Lock()
+}
+
+// Unlock unlocks the mutex of key.
+func (m *mutexKV) Unlock(key string) {
+	m.get(key).Unlock()
+}
+
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+
+	return mutex
+}
+
+// dataSourceSchemaFromResourceSchema turns a resource schema into the
+// computed-only schema of the matching data source, so both expose the same
+// attributes.
+func dataSourceSchemaFromResourceSchema(rs map[string]*schema.Schema) map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(rs))
+
+	for k, v := range rs {
+		ds[k] = dataSourceSchemaFromResourceAttr(v)
+	}
+
+	return ds
+}
+
+func dataSourceSchemaFromResourceAttr(rs *schema.Schema) *schema.Schema {
+	ds := &schema.Schema{
+		Type:        rs.Type,
+		Computed:    true,
+		Sensitive:   rs.Sensitive,
+		Description: rs.Description,
+	}
+
+	switch elem := rs.Elem.(type) {
+	case *schema.Resource:
+		ds.Elem = &schema.Resource{Schema: dataSourceSchemaFromResourceSchema(elem.Schema)}
+	case *schema.Schema:
+		ds.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return ds
+}
+
+// configuredAttr reports whether the top-level attribute or block key is set
+// in raw, the configuration Terraform sent with the request. This tells
+// Optional and Computed attributes set by the user apart from values kept from
+// state. Blocks count as set when they appear at least once. ok is false when
+// no configuration was sent, and callers fall back to the merged value then.
+func configuredAttr(raw cty.Value, key string) (configured bool, ok bool) {
+	if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute(key) {
+		return false, false
+	}
+
+	v := raw.GetAttr(key)
+	if v.IsNull() {
+		return false, true
+	}
+	if v.IsKnown() && (v.Type().IsListType() || v.Type().IsSetType()) {
+		return v.LengthInt() > 0, true
+	}
+
+	return true, true
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/exileed/uptimerobotapi"
+)
+
+func TestRetryTimeCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+
+	start := time.Now()
+	err := retryTime(ctx, func() error {
+		calls++
+		return uptimerobotapi.APIError{StatusCode: 503, Message: "unavailable"}
+	}, time.Hour)
+
+	if err == nil {
+		t.Fatal("expected an error after cancellation")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("retryTime kept running for %s after cancellation", elapsed)
+	}
+	if calls == 0 {
+		t.Fatal("expected retryFunc to be called")
+	}
+}
+
+func TestRetryTimeNotRetryable(t *testing.T) {
+	calls := 0
+	err := retryTime(context.Background(), func() error {
+		calls++
+		return uptimerobotapi.APIError{StatusCode: 400, Message: "bad request"}
+	}, time.Minute)
+
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single call, got %d", calls)
+	}
+}
+
+func TestPauseAfterCreate(t *testing.T) {
+	for _, paused := range []bool{false, true} {
+		d := resourceMonitor().TestResourceData()
+		d.Set("paused", paused)
+
+		calls := 0
+		err := pauseAfterCreate(context.Background(), d, func() error {
+			calls++
+			return nil
+		})
+
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if expected := map[bool]int{false: 0, true: 1}[paused]; calls != expected {
+			t.Fatalf("paused %t: expected %d calls, got %d", paused, expected, calls)
+		}
+	}
+}